@@ -9,6 +9,7 @@
 package gojalaali
 
 import (
+	"database/sql/driver"
 	"time"
 )
 
@@ -25,6 +26,32 @@ type Jalaali interface {
 	// Since returns the number of seconds between t and t2.
 	Since(t2 Jalaali) time.Duration
 
+	// Sub returns the duration t-u. If the result exceeds the maximum (or minimum)
+	// value that can be stored in a Duration, the maximum (or minimum) duration will be returned.
+	Sub(u Jalaali) time.Duration
+
+	// Before reports whether the time instant t is before u.
+	Before(u Jalaali) bool
+
+	// After reports whether the time instant t is after u.
+	After(u Jalaali) bool
+
+	// Equal reports whether t and u represent the same time instant.
+	// Two times can be equal even if they are in different locations.
+	Equal(u Jalaali) bool
+
+	// Compare compares the time instant t with u. If t is before u, it returns -1;
+	// if t is after u, it returns +1; if they're the same, it returns 0.
+	// It can be used with slices.SortFunc.
+	Compare(u Jalaali) int
+
+	// Diff returns the calendar-aware period t-other in jalaali years, months,
+	// days and clock components. other is converted to the location of t.
+	// Months are added with the day clamped to the month end, so
+	// 31 Shahrivar to 30 Mehr is one month and 30 Esfand of a leap year
+	// to 29 Esfand of next year is one year.
+	Diff(other Jalaali) Period
+
 	// AmPm returns the 12-Hour marker of instance.
 	AmPm() AmPm
 
@@ -47,11 +74,35 @@ type Jalaali interface {
 	// to Jalaali and returns a new instance.
 	AddDate(year, month, day int) Jalaali
 
+	// AddDateClamped add year, month and day
+	// to Jalaali and returns a new instance.
+	// Unlike AddDate, day is clamped to the last day of the target month
+	// before adding days (e.g. 31 Shahrivar + 1 month is 30 Mehr
+	// and 30 Esfand 1403 + 1 year is 29 Esfand 1404).
+	AddDateClamped(year, month, day int) Jalaali
+
 	// AddDatetime add the year, month, day,
 	// hour, minute, second and nanoscond to Jalaali
 	// and returns a new instance..
 	AddDatetime(year, month, day, hour, min, sec, nsec int) Jalaali
 
+	// Truncate returns the result of rounding t down to a multiple of d (since the zero time)
+	// like time.Time.Truncate. If d <= 0, Truncate returns t stripped of any monotonic clock reading.
+	Truncate(d time.Duration) Jalaali
+
+	// Round returns the result of rounding t to the nearest multiple of d (since the zero time)
+	// like time.Time.Round. The rounding behavior for halfway values is to round up.
+	Round(d time.Duration) Jalaali
+
+	// TruncateTo returns a new instance of Jalaali representing
+	// the beginning of the calendar unit of instance.
+	TruncateTo(unit Unit) Jalaali
+
+	// CeilTo returns a new instance of Jalaali representing the beginning
+	// of the next calendar unit of instance.
+	// If instance is at the beginning of unit it returns the same moment.
+	CeilTo(unit Unit) Jalaali
+
 	// Yesterday returns a new instance of Jalaali
 	// representing a day before the day of instance.
 	Yesterday() Jalaali
@@ -104,6 +155,16 @@ type Jalaali interface {
 	// and time is set to 23:59:59.999999999.
 	EndOfMonth() Jalaali
 
+	// BeginningOfSeason returns a new instance of Jalaali
+	// representing the first day of the season of instance
+	// and time is set to 00:00:00.000000000.
+	BeginningOfSeason() Jalaali
+
+	// EndOfSeason returns a new instance of Jalaali
+	// representing the last day of the season of instance
+	// and time is set to 23:59:59.999999999.
+	EndOfSeason() Jalaali
+
 	// FirstYearDay returns a new instance of Jalaali
 	// representing the first day of the year of instance.
 	FirstYearDay() Jalaali
@@ -156,6 +217,28 @@ type Jalaali interface {
 	// Pass -1 to ignore parameter.
 	SetDateTime(year, month, day, hour, min, sec, nsec int)
 
+	// WithYear returns a new instance with the year set like SetYear.
+	// Unlike SetYear, instance is not changed.
+	WithYear(year int) Jalaali
+
+	// WithMonth returns a new instance with the month set like SetMonth.
+	// Unlike SetMonth, instance is not changed.
+	WithMonth(month Month) Jalaali
+
+	// WithDay returns a new instance with the day set like SetDay.
+	// Unlike SetDay, instance is not changed.
+	WithDay(day int) Jalaali
+
+	// WithTime returns a new instance with the hour, minute, second
+	// and nanosecond set like SetTime. Pass -1 to ignore parameter.
+	WithTime(hour, min, sec, nsec int) Jalaali
+
+	// WithDate returns a new instance with the year, month and day
+	// set like SetDate. Pass -1 to ignore parameter.
+	// Unlike SetDate, day is clamped once after setting all parts
+	// (e.g. 30 Esfand 1403 with 1402/06 is 30 Shahrivar 1402).
+	WithDate(year, month, day int) Jalaali
+
 	// Year returns the year of t.
 	Year() int
 
@@ -168,6 +251,18 @@ type Jalaali interface {
 	// Month returns the month of t in the range [1, 12].
 	Month() Month
 
+	// Season returns the season of instance.
+	Season() Season
+
+	// Quarter returns the quarter of year of instance in the range [1, 4].
+	Quarter() int
+
+	// SeasonDay returns the day of season of instance.
+	SeasonDay() int
+
+	// Hijri returns the hijri qamari date of instance using TabularHijri.
+	Hijri() Hijri
+
 	// Weekday returns the weekday of instance.
 	Weekday() Weekday
 
@@ -234,6 +329,44 @@ type Jalaali interface {
 	// String returns t in RFC3339 format.
 	String() string
 
+	// MarshalJSON implements the json.Marshaler interface.
+	// Instance is formatted in RFC3339Nano and zero instance is marshaled as null.
+	MarshalJSON() ([]byte, error)
+
+	// UnmarshalJSON implements the json.Unmarshaler interface.
+	// Data is parsed as RFC3339Nano. null and empty string result in zero instance.
+	UnmarshalJSON(data []byte) error
+
+	// MarshalText implements the encoding.TextMarshaler interface.
+	// Instance is formatted in RFC3339 format with nanoseconds.
+	MarshalText() ([]byte, error)
+
+	// UnmarshalText implements the encoding.TextUnmarshaler interface.
+	// Data is parsed in RFC3339 format with nanoseconds.
+	UnmarshalText(data []byte) error
+
+	// MarshalBinary implements the encoding.BinaryMarshaler interface.
+	// Encoded data keeps the zone offset and location name of instance.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+	UnmarshalBinary(data []byte) error
+
+	// GobEncode implements the gob.GobEncoder interface.
+	GobEncode() ([]byte, error)
+
+	// GobDecode implements the gob.GobDecoder interface.
+	GobDecode(data []byte) error
+
+	// Scan implements the sql.Scanner interface.
+	// It accepts time.Time, []byte and string values.
+	// String values parsed as gregorian or jalaali datetime.
+	Scan(value any) error
+
+	// Value implements the driver.Valuer interface.
+	// Instance is stored as gregorian time.Time and zero instance as NULL.
+	Value() (driver.Value, error)
+
 	// TimeFormat formats in standard time package layout.
 	//
 	// Year
@@ -276,6 +409,10 @@ type Jalaali interface {
 	// .999999			Trailing zeros removed microsecond			".1234"
 	// .999999999		Trailing zeros removed nanosecond			".123456"
 	//
+	// Season
+	// Spring			Season name									"پاییز"
+	// QQ				Quarter of year								"3"
+	//
 	// Daytime
 	// Morning			day time									"صبح"
 	// PM				Full 12-Hour marker							"قبل از ظهر"
@@ -294,19 +431,22 @@ type Jalaali interface {
 	// -07:00			zone offset Hour and Minute					"+03:30"
 	// -07				zone offset Hour							"+03"
 	Format(layout string) string
+
+	// FormatFa formats in standard time package layout like Format
+	// and writes numeric parts with persian digits (۰-۹).
+	// Literal text of layout is not changed.
+	FormatFa(layout string) string
+
+	// FormatAr formats in standard time package layout like Format
+	// and writes numeric parts with arabic-indic digits (٠-٩).
+	// Literal text of layout is not changed.
+	FormatAr(layout string) string
 }
 
 // New create new jalaali instance from time.
 // If location is nil then the local time is used.
 func New(t time.Time) Jalaali {
-	if t.Year() < 1097 {
-		return new(jTime)
-	} else {
-		driver := new(jTime)
-		driver.setTime(t)
-		return driver
-	}
-
+	return NewTime(t).Jalaali()
 }
 
 // Date create a new jalaali instance from jalaali date.
@@ -317,9 +457,7 @@ func New(t time.Time) Jalaali {
 //
 // loc is a pointer to time.Location, if loc is nil then the local time is used.
 func Date(year int, month Month, day, hour, min, sec, nsec int, loc *time.Location) Jalaali {
-	driver := new(jTime)
-	driver.set(year, month, day, hour, min, sec, nsec, loc)
-	return driver
+	return TimeDate(year, month, day, hour, min, sec, nsec, loc).Jalaali()
 }
 
 // Unix create a new jalaali instance from unix timestamp.
@@ -335,12 +473,57 @@ func Now() Jalaali {
 
 }
 
+// Until returns the duration until t.
+// It is shorthand for t.Sub(Now()).
+func Until(t Jalaali) time.Duration {
+	return t.Sub(Now())
+}
+
+// Min returns the earliest of values.
+// nil values are skipped. It returns nil if no value
+// passed or all values are nil.
+func Min(values ...Jalaali) Jalaali {
+	var res Jalaali
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if res == nil || v.Before(res) {
+			res = v
+		}
+	}
+	return res
+}
+
+// Max returns the latest of values.
+// nil values are skipped. It returns nil if no value
+// passed or all values are nil.
+func Max(values ...Jalaali) Jalaali {
+	var res Jalaali
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if res == nil || v.After(res) {
+			res = v
+		}
+	}
+	return res
+}
+
+// Package time zones are created once so instances
+// in the same zone are comparable with ==.
+var (
+	tehranTz = time.FixedZone("Asia/Tehran", 12600) // UTC + 03:30
+	kabulTz  = time.FixedZone("Asia/Kabul", 16200)  // UTC + 04:30
+)
+
 // TehranTz get tehran time zone.
 func TehranTz() *time.Location {
-	return time.FixedZone("Asia/Tehran", 12600) // UTC + 03:30
+	return tehranTz
 }
 
 // KabulTz get kabul time zone.
 func KabulTz() *time.Location {
-	return time.FixedZone("Asia/Kabul", 16200) // UTC + 04:30
+	return kabulTz
 }
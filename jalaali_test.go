@@ -1,6 +1,7 @@
 package gojalaali_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -36,6 +37,27 @@ func TestJalaali(t *testing.T) {
 		}
 	})
 
+	t.Run("AddDateClamped", func(t *testing.T) {
+		tests := []struct {
+			date     gojalaali.Jalaali
+			years    int
+			months   int
+			days     int
+			expected string
+		}{
+			{gojalaali.Date(1403, 06, 31, 10, 0, 0, 0, gojalaali.TehranTz()), 0, 1, 0, "1403-07-30T10:00:00+03:30"},
+			{gojalaali.Date(1403, 12, 30, 10, 0, 0, 0, gojalaali.TehranTz()), 1, 0, 0, "1404-12-29T10:00:00+03:30"},
+			{gojalaali.Date(1403, 11, 30, 10, 0, 0, 0, gojalaali.TehranTz()), 0, 1, 1, "1404-01-01T10:00:00+03:30"},
+			{gojalaali.Date(1403, 01, 31, 10, 0, 0, 0, gojalaali.TehranTz()), 0, -2, 0, "1402-11-30T10:00:00+03:30"},
+		}
+		for _, test := range tests {
+			result := test.date.AddDateClamped(test.years, test.months, test.days).String()
+			if test.expected != result {
+				t.Errorf("Expect %s but get %s", test.expected, result)
+			}
+		}
+	})
+
 	t.Run("AddTime", func(t *testing.T) {
 		date := gojalaali.Date(1403, 01, 15, 20, 14, 0, 0, gojalaali.TehranTz()).
 			AddTime(1, 30, 0, 0)
@@ -112,3 +134,163 @@ func TestJalaali(t *testing.T) {
 		}
 	})
 }
+
+func TestCompare(t *testing.T) {
+	a := gojalaali.Date(1403, 01, 15, 20, 14, 0, 0, gojalaali.TehranTz())
+	b := gojalaali.Date(1403, 01, 15, 21, 14, 0, 500, gojalaali.KabulTz())
+	c := gojalaali.Date(1403, 01, 15, 16, 44, 0, 0, time.UTC)
+
+	t.Run("Sub", func(t *testing.T) {
+		expected := -500 * time.Nanosecond
+		if result := a.Sub(b); result != expected {
+			t.Errorf("Expect %s but get %s", expected, result)
+		}
+		if result := b.Sub(a); result != -expected {
+			t.Errorf("Expect %s but get %s", -expected, result)
+		}
+	})
+
+	t.Run("BeforeAfterEqual", func(t *testing.T) {
+		if !a.Before(b) || a.After(b) || !b.After(a) {
+			t.Error("Expect a before b")
+		}
+		if !a.Equal(c) || a.Before(c) || a.After(c) {
+			t.Error("Expect a equal c")
+		}
+	})
+
+	t.Run("Compare", func(t *testing.T) {
+		values := []gojalaali.Jalaali{b, a, c}
+		slices.SortFunc(values, gojalaali.Jalaali.Compare)
+		if values[2] != b || a.Compare(c) != 0 || b.Compare(a) != 1 || a.Compare(b) != -1 {
+			t.Errorf("Unexpected compare result %v", values)
+		}
+	})
+
+	t.Run("MinMax", func(t *testing.T) {
+		if result := gojalaali.Min(b, a); result != a {
+			t.Errorf("Expect %s but get %s", a, result)
+		}
+		if result := gojalaali.Max(a, b, c); result != b {
+			t.Errorf("Expect %s but get %s", b, result)
+		}
+		if gojalaali.Min() != nil {
+			t.Error("Expect nil for empty values")
+		}
+		if result := gojalaali.Min(b, nil, a); result != a {
+			t.Errorf("Expect %s but get %s", a, result)
+		}
+		if result := gojalaali.Max(nil, a, nil, b); result != b {
+			t.Errorf("Expect %s but get %s", b, result)
+		}
+		if gojalaali.Max(nil, nil) != nil {
+			t.Error("Expect nil for nil values")
+		}
+	})
+
+	t.Run("Until", func(t *testing.T) {
+		if result := gojalaali.Until(gojalaali.Now().Add(time.Hour)); result <= 0 || result > time.Hour {
+			t.Errorf("Unexpected until result %s", result)
+		}
+	})
+}
+
+func TestRound(t *testing.T) {
+	date := gojalaali.Date(1403, 07, 15, 20, 14, 35, 0, gojalaali.TehranTz())
+
+	t.Run("Duration", func(t *testing.T) {
+		if result := date.Truncate(time.Minute).String(); result != "1403-07-15T20:14:00+03:30" {
+			t.Errorf("Expect %s but get %s", "1403-07-15T20:14:00+03:30", result)
+		}
+		if result := date.Round(time.Minute).String(); result != "1403-07-15T20:15:00+03:30" {
+			t.Errorf("Expect %s but get %s", "1403-07-15T20:15:00+03:30", result)
+		}
+	})
+
+	t.Run("Unit", func(t *testing.T) {
+		tests := []struct {
+			unit     gojalaali.Unit
+			truncate string
+			ceil     string
+		}{
+			{gojalaali.UnitHour, "1403-07-15T20:00:00+03:30", "1403-07-15T21:00:00+03:30"},
+			{gojalaali.UnitDay, "1403-07-15T00:00:00+03:30", "1403-07-16T00:00:00+03:30"},
+			{gojalaali.UnitWeek, "1403-07-14T00:00:00+03:30", "1403-07-21T00:00:00+03:30"},
+			{gojalaali.UnitMonth, "1403-07-01T00:00:00+03:30", "1403-08-01T00:00:00+03:30"},
+			{gojalaali.UnitSeason, "1403-07-01T00:00:00+03:30", "1403-10-01T00:00:00+03:30"},
+			{gojalaali.UnitYear, "1403-01-01T00:00:00+03:30", "1404-01-01T00:00:00+03:30"},
+		}
+		for _, test := range tests {
+			if result := date.TruncateTo(test.unit).String(); result != test.truncate {
+				t.Errorf("Expect %s but get %s", test.truncate, result)
+			}
+			if result := date.CeilTo(test.unit).String(); result != test.ceil {
+				t.Errorf("Expect %s but get %s", test.ceil, result)
+			}
+		}
+
+		start := date.TruncateTo(gojalaali.UnitSeason)
+		if result := start.CeilTo(gojalaali.UnitSeason); !result.Equal(start) {
+			t.Errorf("Expect %s but get %s", start, result)
+		}
+	})
+}
+
+func TestSeason(t *testing.T) {
+	tests := []struct {
+		date      gojalaali.Jalaali
+		season    gojalaali.Season
+		seasonDay int
+		beginning string
+		end       string
+	}{
+		{gojalaali.Date(1403, 01, 01, 10, 0, 0, 0, gojalaali.TehranTz()), gojalaali.Bahar, 1, "1403-01-01T00:00:00+03:30", "1403-03-31T23:59:59+03:30"},
+		{gojalaali.Date(1403, 05, 10, 10, 0, 0, 0, gojalaali.TehranTz()), gojalaali.Tabestan, 41, "1403-04-01T00:00:00+03:30", "1403-06-31T23:59:59+03:30"},
+		{gojalaali.Date(1403, 07, 15, 10, 0, 0, 0, gojalaali.TehranTz()), gojalaali.Paeez, 15, "1403-07-01T00:00:00+03:30", "1403-09-30T23:59:59+03:30"},
+		{gojalaali.Date(1403, 12, 30, 10, 0, 0, 0, gojalaali.TehranTz()), gojalaali.Zemestan, 90, "1403-10-01T00:00:00+03:30", "1403-12-30T23:59:59+03:30"},
+		{gojalaali.Date(1404, 12, 29, 10, 0, 0, 0, gojalaali.TehranTz()), gojalaali.Zemestan, 89, "1404-10-01T00:00:00+03:30", "1404-12-29T23:59:59+03:30"},
+	}
+
+	for _, test := range tests {
+		if test.date.Season() != test.season || test.date.Quarter() != int(test.season) {
+			t.Errorf("Expect %s but get %s", test.season, test.date.Season())
+		}
+		if test.date.SeasonDay() != test.seasonDay {
+			t.Errorf("Expect %d but get %d", test.seasonDay, test.date.SeasonDay())
+		}
+		if result := test.date.BeginningOfSeason().String(); result != test.beginning {
+			t.Errorf("Expect %s but get %s", test.beginning, result)
+		}
+		if result := test.date.EndOfSeason().String(); result != test.end {
+			t.Errorf("Expect %s but get %s", test.end, result)
+		}
+	}
+
+	if gojalaali.Paeez.String() != "پاییز" || gojalaali.Paeez.Dari() != "خزان" {
+		t.Errorf("Unexpected season names %s, %s", gojalaali.Paeez, gojalaali.Paeez.Dari())
+	}
+}
+
+func TestWith(t *testing.T) {
+	date := gojalaali.Date(1403, 12, 30, 20, 14, 35, 0, gojalaali.TehranTz())
+	tests := []struct {
+		result   gojalaali.Jalaali
+		expected string
+	}{
+		{date.WithYear(1404), "1404-12-29T20:14:35+03:30"},
+		{date.WithMonth(gojalaali.Mehr), "1403-07-30T20:14:35+03:30"},
+		{date.WithDay(1), "1403-12-01T20:14:35+03:30"},
+		{date.WithTime(8, 0, -1, -1), "1403-12-30T08:00:35+03:30"},
+		{date.WithDate(1402, 6, -1), "1402-06-30T20:14:35+03:30"},
+		{date.WithDate(-1, -1, 5).WithTime(0, 0, 0, 0), "1403-12-05T00:00:00+03:30"},
+	}
+	for _, test := range tests {
+		if result := test.result.String(); result != test.expected {
+			t.Errorf("Expect %s but get %s", test.expected, result)
+		}
+	}
+
+	if result := date.String(); result != "1403-12-30T20:14:35+03:30" {
+		t.Errorf("Expect instance not changed but get %s", result)
+	}
+}
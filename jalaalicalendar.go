@@ -0,0 +1,81 @@
+package gojalaali
+
+import "time"
+
+// DaysIn returns the number of days of month in year.
+// It returns 0 if month is out of range.
+func DaysIn(year int, month Month) int {
+	return DaysInWithRule(year, month, nil)
+}
+
+// DaysInWithRule is like DaysIn using rule.
+// ArithmeticRule is used if rule is nil.
+func DaysInWithRule(year int, month Month, rule CalendarRule) int {
+	if month < Farvardin || month > Esfand {
+		return 0
+	}
+	return daysOfMonth(month, IsLeapYearWithRule(year, rule))
+}
+
+// DaysInYear returns the number of days of year (365 or 366).
+func DaysInYear(year int) int {
+	return DaysInYearWithRule(year, nil)
+}
+
+// DaysInYearWithRule is like DaysInYear using rule.
+// ArithmeticRule is used if rule is nil.
+func DaysInYearWithRule(year int, rule CalendarRule) int {
+	if IsLeapYearWithRule(year, rule) {
+		return 366
+	}
+	return 365
+}
+
+// IsLeapYear returns true if year is a leap year under ArithmeticRule.
+// Use IsLeapYearWithRule for other rules.
+func IsLeapYear(year int) bool {
+	return isLeap(year)
+}
+
+// IsLeapYearWithRule returns true if year is a leap year under rule.
+// ArithmeticRule is used if rule is nil.
+func IsLeapYearWithRule(year int, rule CalendarRule) bool {
+	if rule == nil {
+		return isLeap(year)
+	}
+	return rule.IsLeap(year)
+}
+
+// IsValidDate returns true if year, month and day represent an existing day.
+func IsValidDate(year int, month Month, day int) bool {
+	return IsValidDateWithRule(year, month, day, nil)
+}
+
+// IsValidDateWithRule is like IsValidDate using rule.
+// ArithmeticRule is used if rule is nil.
+func IsValidDateWithRule(year int, month Month, day int, rule CalendarRule) bool {
+	return day >= 1 && day <= DaysInWithRule(year, month, rule)
+}
+
+// YearBounds returns the gregorian dates of the first and the last
+// day of jalaali year at midnight in UTC.
+func YearBounds(year int) (first, last time.Time) {
+	return YearBoundsWithRule(year, nil)
+}
+
+// YearBoundsWithRule is like YearBounds using rule.
+// ArithmeticRule is used if rule is nil.
+func YearBoundsWithRule(year int, rule CalendarRule) (first, last time.Time) {
+	if rule == nil {
+		rule = ArithmeticRule{}
+	}
+	start := rule.ToJDN(year, int(Farvardin), 1)
+	end := start + DaysInYearWithRule(year, rule) - 1
+	return gregorianDate(start), gregorianDate(end)
+}
+
+// gregorianDate returns the gregorian date of julian day number at midnight in UTC.
+func gregorianDate(jdn int) time.Time {
+	year, month, day := convertJDNToGregorian(jdn)
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
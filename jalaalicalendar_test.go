@@ -0,0 +1,93 @@
+package gojalaali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestCalendarHelpers(t *testing.T) {
+	t.Run("DaysIn", func(t *testing.T) {
+		tests := []struct {
+			year     int
+			month    gojalaali.Month
+			expected int
+		}{
+			{1403, gojalaali.Farvardin, 31},
+			{1403, gojalaali.Mehr, 30},
+			{1403, gojalaali.Esfand, 30},
+			{1404, gojalaali.Esfand, 29},
+			{1404, 0, 0},
+			{1404, 13, 0},
+		}
+		for _, test := range tests {
+			if res := gojalaali.DaysIn(test.year, test.month); res != test.expected {
+				t.Errorf("Expect %d days in %d/%d, got %d", test.expected, test.year, test.month, res)
+			}
+		}
+
+		if gojalaali.DaysInYear(1403) != 366 || gojalaali.DaysInYear(1404) != 365 {
+			t.Error("Unexpected days in year")
+		}
+		if !gojalaali.IsLeapYear(1403) || gojalaali.IsLeapYear(1404) {
+			t.Error("Unexpected leap year")
+		}
+	})
+
+	t.Run("IsValidDate", func(t *testing.T) {
+		tests := []struct {
+			year     int
+			month    gojalaali.Month
+			day      int
+			expected bool
+		}{
+			{1403, gojalaali.Esfand, 30, true},
+			{1404, gojalaali.Esfand, 30, false},
+			{1404, gojalaali.Shahrivar, 31, true},
+			{1404, gojalaali.Mehr, 31, false},
+			{1404, gojalaali.Mehr, 0, false},
+			{1404, 13, 1, false},
+		}
+		for _, test := range tests {
+			if res := gojalaali.IsValidDate(test.year, test.month, test.day); res != test.expected {
+				t.Errorf("Expect %v for %d/%d/%d", test.expected, test.year, test.month, test.day)
+			}
+		}
+	})
+
+	t.Run("YearBounds", func(t *testing.T) {
+		tests := []struct {
+			year        int
+			first, last time.Time
+		}{
+			{1403, time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)},
+			{1404, time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, test := range tests {
+			first, last := gojalaali.YearBounds(test.year)
+			if !first.Equal(test.first) || !last.Equal(test.last) {
+				t.Errorf("Expect %s - %s for %d, got %s - %s", test.first, test.last, test.year, first, last)
+			}
+		}
+	})
+
+	t.Run("Rule", func(t *testing.T) {
+		rule := gojalaali.BorkowskiRule{}
+		if !gojalaali.IsLeapYearWithRule(1176, rule) || gojalaali.IsLeapYearWithRule(1177, rule) ||
+			gojalaali.IsLeapYearWithRule(1176, nil) || !gojalaali.IsLeapYearWithRule(1177, nil) {
+			t.Error("Unexpected leap year")
+		}
+		if gojalaali.DaysInWithRule(1176, gojalaali.Esfand, rule) != 30 || gojalaali.DaysInYearWithRule(1177, rule) != 365 {
+			t.Error("Unexpected days in year")
+		}
+		if !gojalaali.IsValidDateWithRule(1176, gojalaali.Esfand, 30, rule) || gojalaali.IsValidDate(1176, gojalaali.Esfand, 30) {
+			t.Error("Unexpected valid date")
+		}
+
+		first, last := gojalaali.YearBoundsWithRule(1177, rule)
+		if !first.Equal(time.Date(1798, 3, 21, 0, 0, 0, 0, time.UTC)) || !last.Equal(time.Date(1799, 3, 20, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expect 1798-03-21 - 1799-03-20, got %s - %s", first, last)
+		}
+	})
+}
@@ -12,6 +12,44 @@ type jTime struct {
 	nsec  int
 	loc   *time.Location
 	wday  Weekday
+	rule  CalendarRule // nil for ArithmeticRule
+}
+
+// calendar returns the calendar rule of instance.
+func (jt jTime) calendar() CalendarRule {
+	if jt.rule == nil {
+		return ArithmeticRule{}
+	}
+	return jt.rule
+}
+
+// jdn returns the julian day number of the date of instance.
+func (jt jTime) jdn() int {
+	return jt.calendar().ToJDN(jt.year, int(jt.month), jt.day)
+}
+
+// monthDays returns the number of days of month in year using rule of instance.
+func (jt jTime) monthDays(year int, month Month) int {
+	return daysOfMonth(month, jt.calendar().IsLeap(year))
+}
+
+// ruleOf returns the calendar rule of j.
+func ruleOf(j Jalaali) CalendarRule {
+	if jt, ok := j.(*jTime); ok {
+		return jt.calendar()
+	}
+	return ArithmeticRule{}
+}
+
+// fromTime returns the instance of t with rule of jt.
+// It returns zero instance for years before 1097.
+func (jt jTime) fromTime(t time.Time) jTime {
+	var res jTime
+	if t.Year() >= 1097 {
+		res.rule = jt.rule
+		res.setTime(t)
+	}
+	return res
 }
 
 func (jt *jTime) setTime(t time.Time) {
@@ -23,17 +61,10 @@ func (jt *jTime) setTime(t time.Time) {
 	jt.loc = t.Location()
 	jt.wday = JWeekday(t.Weekday())
 
-	var jdn int
-	gy, gmm, gd := t.Date()
-	gm := int(gmm)
+	gy, gm, gd := t.Date()
+	jdn := convertGregorianToJDN(gy, int(gm), gd)
 
-	if isAfterGregorianReform(gy, gm, gd) {
-		jdn = convertGregorianPostReformToJDN(gy, gm, gd)
-	} else {
-		jdn = convertGregorianPreReformToJDN(gy, gm, gd)
-	}
-
-	year, month, day = convertJDNToShamsi(jdn)
+	year, month, day = jt.calendar().FromJDN(jdn)
 
 	jt.year = year
 	jt.month = Month(month)
@@ -90,7 +121,7 @@ func (jt *jTime) set(year int, month Month, day, hour, min, sec, nsec int, loc *
 		m = 11
 	}
 
-	if isLeap(year) {
+	if jt.calendar().IsLeap(year) {
 		m, day = normDay(m, day, monthMeta[m][1])
 	} else {
 		m, day = normDay(m, day, monthMeta[m][0])
@@ -109,20 +140,6 @@ func (jt *jTime) set(year int, month Month, day, hour, min, sec, nsec int, loc *
 	jt.normalize()
 }
 
-func (jt jTime) clone() *jTime {
-	return &jTime{
-		year:  jt.year,
-		month: jt.month,
-		day:   jt.day,
-		hour:  jt.hour,
-		min:   jt.min,
-		sec:   jt.sec,
-		nsec:  jt.nsec,
-		loc:   jt.loc,
-		wday:  jt.wday,
-	}
-}
-
 func (jt *jTime) normalize() {
 	jt.normalizeNano()
 	jt.normalizeSec()
@@ -0,0 +1,238 @@
+package gojalaali
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// jdateLayout is the layout used to marshal and unmarshal JDate to and from JSON and text.
+const jdateLayout = time.DateOnly
+
+// JDate represents a jalaali calendar date without time and location.
+// JDate is comparable and the zero value represents no date.
+// JDate always uses ArithmeticRule.
+type JDate struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// NewJDate create a new date from year, month and day.
+// Out of range month and day are normalized, e.g. 1403/12/31 become 1404/01/01.
+func NewJDate(year int, month Month, day int) JDate {
+	total := year*12 + int(month) - 1
+	year = floorDiv(total, 12)
+	month = Month(total-year*12) + 1
+	return jdateFromJDN(convertShamsiToJDN(year, int(month), 1) + day - 1)
+}
+
+// JDateOf returns the date of j in loc.
+// The location of j is used if loc is nil.
+// The result is the same day as j under ArithmeticRule, so it may differ
+// from j.Date() for instances created with other rules.
+func JDateOf(j Jalaali, loc *time.Location) JDate {
+	if j == nil || j.IsZero() {
+		return JDate{}
+	}
+	if loc != nil {
+		j = New(j.Time().In(loc))
+	}
+	return jdateFromJDN(jdnOf(j))
+}
+
+// Today returns the current date in loc.
+// Local is used if loc is nil.
+func Today(loc *time.Location) JDate {
+	if loc == nil {
+		loc = time.Local
+	}
+	return JDateOf(Now(), loc)
+}
+
+// ParseJDate parses a formatted string and returns the date it represents.
+// Layout is like Parse and time parts are ignored.
+func ParseJDate(layout, value string) (JDate, error) {
+	res, err := Parse(layout, value)
+	if err != nil {
+		return JDate{}, err
+	}
+	return JDateOf(res, nil), nil
+}
+
+// IsZero reports whether d is the zero value.
+func (d JDate) IsZero() bool {
+	return d == JDate{}
+}
+
+// IsValid reports whether d represents an existing day.
+func (d JDate) IsValid() bool {
+	return IsValidDate(d.Year, d.Month, d.Day)
+}
+
+// Jalaali returns the beginning of d in loc.
+// Local is used if loc is nil.
+func (d JDate) Jalaali(loc *time.Location) Jalaali {
+	if d.IsZero() {
+		return new(jTime)
+	}
+	return Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d. Negative n moves backward.
+func (d JDate) AddDays(n int) JDate {
+	return jdateFromJDN(d.jdn() + n)
+}
+
+// AddMonths returns the date n months after d. Negative n moves backward.
+// Day is clamped to the last day of target month, e.g. 1403/06/31 + 1 month is 1403/07/30.
+func (d JDate) AddMonths(n int) JDate {
+	total := d.Year*12 + int(d.Month) - 1 + n
+	year := floorDiv(total, 12)
+	month := Month(total-year*12) + 1
+	return JDate{Year: year, Month: month, Day: min(d.Day, monthDays(year, month))}
+}
+
+// Sub returns the number of days from u to d.
+func (d JDate) Sub(u JDate) int {
+	return d.jdn() - u.jdn()
+}
+
+// Before reports whether d is before u.
+func (d JDate) Before(u JDate) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is after u.
+func (d JDate) After(u JDate) bool {
+	return d.Compare(u) > 0
+}
+
+// Compare compares d with u. It returns -1 if d is before u,
+// 0 if they are the same day and +1 if d is after u.
+func (d JDate) Compare(u JDate) int {
+	return cmp.Compare(d.jdn(), u.jdn())
+}
+
+// Weekday returns the day of week of d.
+func (d JDate) Weekday() Weekday {
+	return jdnWeekday(d.jdn())
+}
+
+// YearDay returns the day of year of d in the range [1, 366].
+func (d JDate) YearDay() int {
+	return d.jdn() - convertShamsiToJDN(d.Year, int(Farvardin), 1) + 1
+}
+
+// String returns d in DateOnly layout.
+func (d JDate) String() string {
+	return d.Format(jdateLayout)
+}
+
+// Format returns a textual representation of d like Jalaali Format.
+// Time parts are formatted as midnight in UTC, so zone tokens print UTC
+// and month names are Iranian. Use FormatIn for other locations.
+func (d JDate) Format(layout string) string {
+	return d.Jalaali(time.UTC).Format(layout)
+}
+
+// FormatIn is like Format but formats d as midnight in loc.
+// Zone tokens use loc and month names are Dari in KabulTz.
+// UTC is used if loc is nil.
+func (d JDate) FormatIn(layout string, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return d.Jalaali(loc).Format(layout)
+}
+
+// FormatFa is like Format but writes digits in Persian.
+func (d JDate) FormatFa(layout string) string {
+	return d.Jalaali(time.UTC).FormatFa(layout)
+}
+
+func (d JDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format(jdateLayout))
+}
+
+func (d *JDate) UnmarshalJSON(data []byte) error {
+	// Handle null
+	if string(data) == "null" {
+		*d = JDate{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
+func (d JDate) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.Format(jdateLayout)), nil
+}
+
+func (d *JDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = JDate{}
+		return nil
+	}
+
+	res, err := ParseJDate(jdateLayout, string(data))
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts the same values as Jalaali Scan and keeps the date part.
+func (d *JDate) Scan(value any) error {
+	var jt jTime
+	if err := jt.Scan(value); err != nil {
+		return err
+	}
+	*d = JDateOf(&jt, nil)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the gregorian date at midnight in UTC or nil for zero value.
+func (d JDate) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return gregorianDate(d.jdn()), nil
+}
+
+// jdn returns the julian day number of d.
+func (d JDate) jdn() int {
+	return convertShamsiToJDN(d.Year, int(d.Month), d.Day)
+}
+
+// jdnOf returns the julian day number of the date of j
+// regardless of the calendar rule of j.
+func jdnOf(j Jalaali) int {
+	year, month, day := j.Time().Date()
+	return convertGregorianToJDN(year, int(month), day)
+}
+
+// jdnWeekday returns the weekday of julian day number.
+func jdnWeekday(jdn int) Weekday {
+	return Weekday((jdn%7 + 9) % 7)
+}
+
+// jdateFromJDN returns the date of julian day number.
+func jdateFromJDN(jdn int) JDate {
+	year, month, day := convertJDNToShamsi(jdn)
+	return JDate{Year: year, Month: Month(month), Day: day}
+}
@@ -0,0 +1,172 @@
+package gojalaali_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestJDate(t *testing.T) {
+	t.Run("New", func(t *testing.T) {
+		tests := []struct {
+			year     int
+			month    gojalaali.Month
+			day      int
+			expected string
+		}{
+			{1403, 12, 30, "1403-12-30"},
+			{1403, 12, 31, "1404-01-01"},
+			{1404, 12, 30, "1405-01-01"},
+			{1404, 13, 1, "1405-01-01"},
+			{1404, 1, 0, "1403-12-30"},
+		}
+		for _, test := range tests {
+			res := gojalaali.NewJDate(test.year, test.month, test.day)
+			if res.String() != test.expected {
+				t.Errorf("Expect %s for %d/%d/%d, got %s", test.expected, test.year, test.month, test.day, res)
+			}
+		}
+	})
+
+	t.Run("Arithmetic", func(t *testing.T) {
+		d := gojalaali.JDate{Year: 1403, Month: gojalaali.Shahrivar, Day: 31}
+		tests := []struct {
+			res      gojalaali.JDate
+			expected string
+		}{
+			{d.AddDays(1), "1403-07-01"},
+			{d.AddDays(-31), "1403-05-31"},
+			{d.AddDays(365), "1404-06-30"},
+			{d.AddMonths(1), "1403-07-30"},
+			{d.AddMonths(6), "1403-12-30"},
+			{d.AddMonths(18), "1404-12-29"},
+			{d.AddMonths(-7), "1402-11-30"},
+		}
+		for _, test := range tests {
+			if test.res.String() != test.expected {
+				t.Errorf("Expect %s, got %s", test.expected, test.res)
+			}
+		}
+
+		if res := d.AddDays(100).Sub(d); res != 100 {
+			t.Errorf("Expect 100 days, got %d", res)
+		}
+		if d.Weekday() != gojalaali.Shanbeh { // 1403/06/31 is 2024-09-21
+			t.Errorf("Expect Shanbeh, got %s", d.Weekday())
+		}
+		if d.YearDay() != 186 {
+			t.Errorf("Expect year day 186, got %d", d.YearDay())
+		}
+	})
+
+	t.Run("Compare", func(t *testing.T) {
+		a := gojalaali.JDate{Year: 1403, Month: 12, Day: 30}
+		b := gojalaali.JDate{Year: 1404, Month: 1, Day: 1}
+		if !a.Before(b) || a.After(b) || a.Compare(b) != -1 || b.Compare(a) != 1 {
+			t.Error("Expect a before b")
+		}
+		if a != gojalaali.NewJDate(1404, 1, 0) || a.Compare(a) != 0 {
+			t.Error("Expect a equal to itself")
+		}
+	})
+
+	t.Run("Convert", func(t *testing.T) {
+		// 1403/01/01 00:30 in Tehran is 1402/12/29 in UTC
+		j := gojalaali.Date(1403, 1, 1, 0, 30, 0, 0, gojalaali.TehranTz())
+		if res := gojalaali.JDateOf(j, nil); res.String() != "1403-01-01" {
+			t.Errorf("Expect 1403-01-01, got %s", res)
+		}
+		if res := gojalaali.JDateOf(j, time.UTC); res.String() != "1402-12-29" {
+			t.Errorf("Expect 1402-12-29, got %s", res)
+		}
+
+		d := gojalaali.JDate{Year: 1403, Month: 1, Day: 1}
+		res := d.Jalaali(gojalaali.TehranTz())
+		if res.String() != "1403-01-01T00:00:00+03:30" {
+			t.Errorf("Expect 1403-01-01T00:00:00+03:30, got %s", res)
+		}
+		// Same day in ArithmeticRule
+		j = gojalaali.DateWithRule(1177, 1, 1, 0, 0, 0, 0, time.UTC, gojalaali.BorkowskiRule{})
+		if res := gojalaali.JDateOf(j, nil); res.String() != "1177-01-02" || !res.Jalaali(time.UTC).Time().Equal(j.Time()) {
+			t.Errorf("Expect 1177-01-02, got %s", res)
+		}
+		if !gojalaali.JDateOf(nil, nil).IsZero() || !(gojalaali.JDate{}).Jalaali(nil).IsZero() {
+			t.Error("Expect zero conversion")
+		}
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		d := gojalaali.JDate{Year: 1403, Month: 1, Day: 2}
+		if res := d.Format("Monday 2 January 2006"); res != "پنج‌شنبه 2 فروردین 1403" {
+			t.Errorf("Unexpected format %q", res)
+		}
+		if res := d.FormatFa("2006/01/02"); res != "۱۴۰۳/۰۱/۰۲" {
+			t.Errorf("Unexpected format %q", res)
+		}
+		if res := d.FormatIn("2 January 2006 MST", gojalaali.KabulTz()); res != "2 حمل 1403 Asia/Kabul" {
+			t.Errorf("Unexpected format %q", res)
+		}
+
+		res, err := gojalaali.ParseJDate("2006/01/02 15:04", "1403/01/02 23:59")
+		if err != nil || res != d {
+			t.Errorf("Expect %s, got %s (%v)", d, res, err)
+		}
+		if _, err := gojalaali.ParseJDate("2006/01/02", "1404/12/30"); err == nil {
+			t.Error("Expect error for invalid date")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type invoice struct {
+			Issued gojalaali.JDate `json:"issued"`
+			Due    gojalaali.JDate `json:"due"`
+		}
+
+		data, err := json.Marshal(invoice{Issued: gojalaali.JDate{Year: 1403, Month: 7, Day: 5}})
+		if err != nil || string(data) != `{"issued":"1403-07-05","due":null}` {
+			t.Errorf("Unexpected json %s (%v)", data, err)
+		}
+
+		var res invoice
+		if err := json.Unmarshal([]byte(`{"issued":"1403-07-05","due":""}`), &res); err != nil {
+			t.Fatal(err)
+		}
+		if res.Issued.String() != "1403-07-05" || !res.Due.IsZero() {
+			t.Errorf("Unexpected value %+v", res)
+		}
+		if err := json.Unmarshal([]byte(`{"issued":"1403-07-32"}`), &res); err == nil {
+			t.Error("Expect error for invalid date")
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		tests := []struct {
+			value    any
+			expected string
+		}{
+			{time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), "1403-01-01"},
+			{"2024-03-20", "1403-01-01"},
+			{[]byte("1403-01-01"), "1403-01-01"},
+			{nil, ""},
+		}
+		for _, test := range tests {
+			var d gojalaali.JDate
+			if err := d.Scan(test.value); err != nil {
+				t.Fatal(err)
+			}
+			if d.IsZero() && test.expected != "" || !d.IsZero() && d.String() != test.expected {
+				t.Errorf("Expect %q for %v, got %s", test.expected, test.value, d)
+			}
+		}
+
+		v, err := gojalaali.JDate{Year: 1403, Month: 1, Day: 1}.Value()
+		if err != nil || !v.(time.Time).Equal(time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Unexpected value %v (%v)", v, err)
+		}
+		if v, _ := (gojalaali.JDate{}).Value(); v != nil {
+			t.Errorf("Expect nil value, got %v", v)
+		}
+	})
+}
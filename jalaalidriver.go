@@ -10,11 +10,31 @@ func (jt jTime) IsZero() bool {
 }
 
 func (jt jTime) IsLeap() bool {
-	return isLeap(jt.year)
+	return jt.calendar().IsLeap(jt.year)
 }
 
 func (jt jTime) Since(t2 Jalaali) time.Duration {
-	return time.Duration(math.Abs(float64(t2.Unix()-jt.Unix()))) * time.Second
+	return Time{jt}.Since(TimeOf(t2))
+}
+
+func (jt jTime) Sub(u Jalaali) time.Duration {
+	return Time{jt}.Sub(TimeOf(u))
+}
+
+func (jt jTime) Before(u Jalaali) bool {
+	return Time{jt}.Before(TimeOf(u))
+}
+
+func (jt jTime) After(u Jalaali) bool {
+	return Time{jt}.After(TimeOf(u))
+}
+
+func (jt jTime) Equal(u Jalaali) bool {
+	return Time{jt}.Equal(TimeOf(u))
+}
+
+func (jt jTime) Compare(u Jalaali) int {
+	return Time{jt}.Compare(TimeOf(u))
 }
 
 func (jt jTime) AmPm() AmPm {
@@ -29,170 +49,98 @@ func (jt jTime) Zone() (string, int) {
 }
 
 func (jt jTime) In(loc *time.Location) Jalaali {
-	res := jt.clone()
-	if loc != nil {
-		res.loc = loc
-	}
-	res.resetWeekday()
-	return res
+	return Time{jt}.In(loc).Jalaali()
 }
 
 func (jt jTime) Add(d time.Duration) Jalaali {
-	return New(jt.Time().Add(d))
+	return Time{jt}.Add(d).Jalaali()
 }
 
 func (jt jTime) AddTime(hour, min, sec, nsec int) Jalaali {
-	hours := time.Duration(hour) * time.Hour
-	mins := time.Duration(min) * time.Minute
-	secs := time.Duration(sec) * time.Second
-	nanos := time.Duration(nsec) * time.Nanosecond
-	return jt.Add(hours + mins + secs + nanos)
+	return Time{jt}.AddTime(hour, min, sec, nsec).Jalaali()
 }
 
 func (jt jTime) AddDate(year, month, day int) Jalaali {
-	return Date(
-		jt.year+year, jt.month+Month(month), jt.day+day,
-		jt.hour, jt.min, jt.sec, jt.nsec, jt.loc,
-	)
+	return Time{jt}.AddDate(year, month, day).Jalaali()
+}
+
+func (jt jTime) AddDateClamped(year, month, day int) Jalaali {
+	return Time{jt}.AddDateClamped(year, month, day).Jalaali()
 }
 
 func (jt jTime) AddDatetime(year, month, day, hour, min, sec, nsec int) Jalaali {
-	return Date(
-		jt.year+year, jt.month+Month(month), jt.day+day,
-		jt.hour+hour, jt.min+min, jt.sec+sec,
-		jt.nsec+nsec, jt.loc,
-	)
+	return Time{jt}.AddDatetime(year, month, day, hour, min, sec, nsec).Jalaali()
 }
 
 func (jt jTime) Yesterday() Jalaali {
-	return jt.AddDate(0, 0, -1)
+	return Time{jt}.Yesterday().Jalaali()
 }
 
 func (jt jTime) Tomorrow() Jalaali {
-	return jt.AddDate(0, 0, 1)
+	return Time{jt}.Tomorrow().Jalaali()
 }
 
 func (jt jTime) BeginningOfDay() Jalaali {
-	res := jt.clone()
-	res.SetTime(0, 0, 0, 0)
-	return res
+	return Time{jt}.BeginningOfDay().Jalaali()
 }
 func (jt jTime) EndOfDay() Jalaali {
-	res := jt.clone()
-	res.SetTime(23, 59, 59, 999999999)
-	return res
+	return Time{jt}.EndOfDay().Jalaali()
 }
 
 func (jt jTime) FirstWeekDay() Jalaali {
-	if jt.wday == Shanbeh {
-		return jt.clone()
-	}
-	return jt.AddDate(0, 0, int(Shanbeh-jt.wday))
+	return Time{jt}.FirstWeekDay().Jalaali()
 }
 
 func (jt jTime) LastWeekDay() Jalaali {
-	if jt.wday == Jomeh {
-		return jt.clone()
-	}
-	return jt.AddDate(0, 0, int(Jomeh-jt.wday))
+	return Time{jt}.LastWeekDay().Jalaali()
 }
 
 func (jt jTime) BeginningOfWeek() Jalaali {
-	res := jt.FirstWeekDay()
-	res.SetTime(0, 0, 0, 0)
-	return res
+	return Time{jt}.BeginningOfWeek().Jalaali()
 }
 
 func (jt jTime) EndOfWeek() Jalaali {
-	res := jt.LastWeekDay()
-	res.SetTime(23, 59, 59, 999999999)
-	return res
+	return Time{jt}.EndOfWeek().Jalaali()
 }
 
 func (jt jTime) FirstMonthDay() Jalaali {
-	if jt.day == 1 {
-		return jt.clone()
-	}
-	return Date(
-		jt.year, jt.month, 1,
-		jt.hour, jt.min, jt.sec,
-		jt.nsec, jt.loc,
-	)
+	return Time{jt}.FirstMonthDay().Jalaali()
 }
 
 func (jt jTime) LastMonthDay() Jalaali {
-	dIndex := 0
-	if jt.IsLeap() {
-		dIndex = 1
-	}
-
-	mIndex := jt.month - 1
-	if mIndex < 0 {
-		mIndex = 0
-	} else if mIndex > 11 {
-		mIndex = 11
-	}
-
-	lastDay := monthMeta[mIndex][dIndex]
-	if lastDay == jt.day {
-		return jt.clone()
-	}
-	return Date(
-		jt.year, jt.month, lastDay,
-		jt.hour, jt.min, jt.sec,
-		jt.nsec, jt.loc,
-	)
+	return Time{jt}.LastMonthDay().Jalaali()
 }
 
 func (jt jTime) BeginningOfMonth() Jalaali {
-	res := jt.FirstMonthDay()
-	res.SetTime(0, 0, 0, 0)
-	return res
+	return Time{jt}.BeginningOfMonth().Jalaali()
 }
 
 func (jt jTime) EndOfMonth() Jalaali {
-	res := jt.LastMonthDay()
-	res.SetTime(23, 59, 59, 999999999)
-	return res
+	return Time{jt}.EndOfMonth().Jalaali()
+}
+
+func (jt jTime) BeginningOfSeason() Jalaali {
+	return Time{jt}.BeginningOfSeason().Jalaali()
+}
+
+func (jt jTime) EndOfSeason() Jalaali {
+	return Time{jt}.EndOfSeason().Jalaali()
 }
 
 func (jt jTime) FirstYearDay() Jalaali {
-	if jt.month == Farvardin && jt.day == 1 {
-		return jt.clone()
-	}
-	return Date(
-		jt.year, Farvardin, 1,
-		jt.hour, jt.min, jt.sec,
-		jt.nsec, jt.loc,
-	)
+	return Time{jt}.FirstYearDay().Jalaali()
 }
 
 func (jt jTime) LastYearDay() Jalaali {
-	dIndex := 0
-	if jt.IsLeap() {
-		dIndex = 1
-	}
-	lastDay := monthMeta[Esfand-1][dIndex]
-	if jt.month == Esfand && jt.day == lastDay {
-		return jt.clone()
-	}
-	return Date(
-		jt.year, Esfand, lastDay,
-		jt.hour, jt.min, jt.sec,
-		jt.nsec, jt.loc,
-	)
+	return Time{jt}.LastYearDay().Jalaali()
 }
 
 func (jt jTime) BeginningOfYear() Jalaali {
-	res := jt.FirstYearDay()
-	res.SetTime(0, 0, 0, 0)
-	return res
+	return Time{jt}.BeginningOfYear().Jalaali()
 }
 
 func (jt jTime) EndOfYear() Jalaali {
-	res := jt.LastYearDay()
-	res.SetTime(23, 59, 59, 999999999)
-	return res
+	return Time{jt}.EndOfYear().Jalaali()
 }
 
 func (jt *jTime) SetYear(year int) {
@@ -266,6 +214,26 @@ func (jt *jTime) SetDateTime(year, month, day, hour, min, sec, nsec int) {
 	jt.SetTime(hour, min, sec, nsec)
 }
 
+func (jt jTime) WithYear(year int) Jalaali {
+	return Time{jt}.WithYear(year).Jalaali()
+}
+
+func (jt jTime) WithMonth(month Month) Jalaali {
+	return Time{jt}.WithMonth(month).Jalaali()
+}
+
+func (jt jTime) WithDay(day int) Jalaali {
+	return Time{jt}.WithDay(day).Jalaali()
+}
+
+func (jt jTime) WithTime(hour, min, sec, nsec int) Jalaali {
+	return Time{jt}.WithTime(hour, min, sec, nsec).Jalaali()
+}
+
+func (jt jTime) WithDate(year, month, day int) Jalaali {
+	return Time{jt}.WithDate(year, month, day).Jalaali()
+}
+
 func (jt jTime) Year() int {
 	return jt.year
 }
@@ -292,16 +260,34 @@ func (jt jTime) Month() Month {
 	return jt.month
 }
 
+func (jt jTime) Season() Season {
+	return Season(jt.Quarter())
+}
+
+func (jt jTime) Quarter() int {
+	month := jt.month
+	if month < Farvardin {
+		month = Farvardin
+	} else if month > Esfand {
+		month = Esfand
+	}
+	return int(month-1)/3 + 1
+}
+
+func (jt jTime) SeasonDay() int {
+	return jt.YearDay() - monthMeta[jt.Season().FirstMonth()-1][2]
+}
+
 func (jt jTime) Weekday() Weekday {
 	return jt.wday
 }
 
 func (jt jTime) MonthWeek() int {
-	return int(math.Ceil(float64(jt.day+int(jt.FirstMonthDay().Weekday())) / 7.0))
+	return int(math.Ceil(float64(jt.day+int(Time{jt}.FirstMonthDay().Weekday())) / 7.0))
 }
 
 func (jt jTime) YearWeek() int {
-	return int(math.Ceil(float64(jt.YearDay()+int(jt.FirstYearDay().Weekday())) / 7.0))
+	return int(math.Ceil(float64(jt.YearDay()+int(Time{jt}.FirstYearDay().Weekday())) / 7.0))
 }
 
 func (jt jTime) YearRemainWeeks() int {
@@ -382,17 +368,11 @@ func (jt jTime) Time() time.Time {
 		return time.Time{}
 	}
 
-	var year, month, day int
-
 	// Convert the Shamsi to the corresponding Julian Day Number (JDN)
-	jdn := convertShamsiToJDN(jt.year, int(jt.month), jt.day)
+	jdn := jt.jdn()
 
 	// Convert the JDN to a Gregorian testDate
-	if jdn > gregorianReformJulianDay {
-		year, month, day = convertJDNToGregorianPostReform(jdn)
-	} else {
-		year, month, day = convertJDNToGregorianPreReform(jdn)
-	}
+	year, month, day := convertJDNToGregorian(jdn)
 
 	// Use the location stored in the Time struct, or default to the local time zone
 	loc := jt.loc
@@ -0,0 +1,241 @@
+package gojalaali
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// jsonLayout is the layout used to marshal and unmarshal Jalaali to and from JSON.
+const jsonLayout = time.RFC3339Nano
+
+// binaryVersion is the version of binary encoding format.
+const binaryVersion byte = 1
+
+func (jt jTime) MarshalJSON() ([]byte, error) {
+	return jt.marshalJSON(jsonLayout)
+}
+
+func (jt *jTime) UnmarshalJSON(data []byte) error {
+	return jt.unmarshalJSON(data, jsonLayout)
+}
+
+// marshalJSON format instance as JSON string using layout.
+func (jt jTime) marshalJSON(layout string) ([]byte, error) {
+	if jt.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(jt.encoded().Format(layout))
+}
+
+// unmarshalJSON parse JSON string using layout.
+func (jt *jTime) unmarshalJSON(data []byte, layout string) error {
+	// Handle null
+	if string(data) == "null" {
+		*jt = jTime{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	// Handle empty string
+	if str == "" {
+		*jt = jTime{}
+		return nil
+	}
+
+	res, err := Parse(layout, str)
+	if err != nil {
+		return err
+	}
+	*jt = *res.(*jTime)
+	return nil
+}
+
+// LayoutProvider provides the layout of JSONLayout.
+// Any layout supported by Format and Parse can be returned.
+type LayoutProvider interface {
+	Layout() string
+}
+
+// DateOnlyLayout provides time.DateOnly layout for JSONLayout.
+type DateOnlyLayout struct{}
+
+// Layout returns time.DateOnly.
+func (DateOnlyLayout) Layout() string {
+	return time.DateOnly
+}
+
+// DateTimeLayout provides time.DateTime layout for JSONLayout.
+type DateTimeLayout struct{}
+
+// Layout returns time.DateTime.
+func (DateTimeLayout) Layout() string {
+	return time.DateTime
+}
+
+// JSONLayout wraps Time to marshal and unmarshal JSON using the layout of L
+// instead of RFC3339Nano. Zero value is marshaled as null.
+//
+//	type Event struct {
+//		Day gojalaali.JSONLayout[gojalaali.DateOnlyLayout]
+//	}
+type JSONLayout[L LayoutProvider] struct {
+	Time
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (j JSONLayout[L]) MarshalJSON() ([]byte, error) {
+	var l L
+	return j.jt.marshalJSON(l.Layout())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (j *JSONLayout[L]) UnmarshalJSON(data []byte) error {
+	var l L
+	return j.jt.unmarshalJSON(data, l.Layout())
+}
+
+func (jt jTime) MarshalText() ([]byte, error) {
+	if jt.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(jt.encoded().Format(time.RFC3339Nano)), nil
+}
+
+func (jt *jTime) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*jt = jTime{}
+		return nil
+	}
+
+	res, err := Parse(time.RFC3339Nano, string(data))
+	if err != nil {
+		return err
+	}
+	*jt = *res.(*jTime)
+	return nil
+}
+
+// MarshalBinary encode instance in following format:
+//
+//	[0]       version
+//	[1:5]     year
+//	[5]       month
+//	[6]       day
+//	[7]       hour
+//	[8]       minute
+//	[9]       second
+//	[10:14]   nanosecond
+//	[14:18]   zone offset in seconds east of UTC
+//	[18]      length of location name
+//	[19:]     location name
+//
+// Zero instance is encoded as version only.
+func (jt jTime) MarshalBinary() ([]byte, error) {
+	if jt.IsZero() {
+		return []byte{binaryVersion}, nil
+	}
+	jt = jt.encoded()
+
+	name := ""
+	if jt.loc != nil {
+		name = jt.loc.String()
+	}
+	if len(name) > 255 {
+		return nil, errors.New("location name too long")
+	}
+
+	_, offset := jt.Zone()
+	data := make([]byte, 19, 19+len(name))
+	data[0] = binaryVersion
+	binary.BigEndian.PutUint32(data[1:5], uint32(int32(jt.year)))
+	data[5] = byte(jt.month)
+	data[6] = byte(jt.day)
+	data[7] = byte(jt.hour)
+	data[8] = byte(jt.min)
+	data[9] = byte(jt.sec)
+	binary.BigEndian.PutUint32(data[10:14], uint32(jt.nsec))
+	binary.BigEndian.PutUint32(data[14:18], uint32(int32(offset)))
+	data[18] = byte(len(name))
+	return append(data, name...), nil
+}
+
+func (jt *jTime) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("no data")
+	}
+
+	if data[0] != binaryVersion {
+		return errors.New("unsupported binary version")
+	}
+
+	// Handle zero
+	if len(data) == 1 {
+		*jt = jTime{}
+		return nil
+	}
+
+	if len(data) < 19 || len(data) != 19+int(data[18]) {
+		return errors.New("invalid binary length")
+	}
+
+	year := int(int32(binary.BigEndian.Uint32(data[1:5])))
+	offset := int(int32(binary.BigEndian.Uint32(data[14:18])))
+	name := string(data[19:])
+
+	res := new(jTime)
+	res.set(
+		year, Month(data[5]), int(data[6]),
+		int(data[7]), int(data[8]), int(data[9]),
+		int(binary.BigEndian.Uint32(data[10:14])),
+		binaryLocation(name, offset),
+	)
+
+	// Fallback to fixed zone if local offset not matched
+	if res.loc == time.Local {
+		if _, o := res.Zone(); o != offset {
+			res.loc = time.FixedZone("", offset)
+		}
+	}
+
+	*jt = *res
+	return nil
+}
+
+func (jt jTime) GobEncode() ([]byte, error) {
+	return jt.MarshalBinary()
+}
+
+func (jt *jTime) GobDecode(data []byte) error {
+	return jt.UnmarshalBinary(data)
+}
+
+// encoded returns jt with ArithmeticRule used by encodings.
+// Instant is kept and calendar rule is not encoded.
+func (jt jTime) encoded() jTime {
+	if jt.rule == nil {
+		return jt
+	}
+	return jTime{}.fromTime(jt.Time())
+}
+
+// binaryLocation resolve decoded location from name and offset.
+func binaryLocation(name string, offset int) *time.Location {
+	switch {
+	case name == "UTC" && offset == 0:
+		return time.UTC
+	case name == "Local":
+		return time.Local
+	case name == tehranTz.String() && offset == 12600:
+		return tehranTz
+	case name == kabulTz.String() && offset == 16200:
+		return kabulTz
+	default:
+		return time.FixedZone(name, offset)
+	}
+}
@@ -0,0 +1,164 @@
+package gojalaali_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestJSON(t *testing.T) {
+	t.Run("Marshal", func(t *testing.T) {
+		date := gojalaali.Date(1403, 01, 15, 20, 14, 0, 120, gojalaali.TehranTz())
+		expected := `{"date":"1403-01-15T20:14:00.000000120+03:30"}`
+		result, err := json.Marshal(map[string]gojalaali.Jalaali{"date": date})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected != string(result) {
+			t.Errorf("Expect %s but get %s", expected, result)
+		}
+	})
+
+	t.Run("MarshalZero", func(t *testing.T) {
+		expected := "null"
+		result, err := json.Marshal(gojalaali.New(time.Time{}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expected != string(result) {
+			t.Errorf("Expect %s but get %s", expected, result)
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		date := gojalaali.New(time.Time{})
+		err := json.Unmarshal([]byte(`"1403-01-15T20:14:00.000000120+03:30"`), date)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := gojalaali.Date(1403, 01, 15, 20, 14, 0, 120, gojalaali.TehranTz()).UnixNano()
+		result := date.UnixNano()
+		if expected != result {
+			t.Errorf("Expect %d but get %d", expected, result)
+		}
+	})
+
+	t.Run("UnmarshalEmpty", func(t *testing.T) {
+		for _, data := range []string{`null`, `""`} {
+			date := gojalaali.Now()
+			if err := json.Unmarshal([]byte(data), date); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !date.IsZero() {
+				t.Errorf("Expect zero instance for %s", data)
+			}
+		}
+	})
+
+	t.Run("UnmarshalInvalid", func(t *testing.T) {
+		date := gojalaali.New(time.Time{})
+		if err := json.Unmarshal([]byte(`"1403-13-15"`), date); err == nil {
+			t.Error("Expect error for invalid input")
+		}
+	})
+
+	t.Run("Layout", func(t *testing.T) {
+		type event struct {
+			Day gojalaali.JSONLayout[gojalaali.DateOnlyLayout]
+		}
+
+		date := gojalaali.TimeDate(1403, 07, 15, 0, 0, 0, 0, time.UTC)
+		data, err := json.Marshal(event{Day: gojalaali.JSONLayout[gojalaali.DateOnlyLayout]{Time: date}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != `{"Day":"1403-07-15"}` {
+			t.Errorf("Expect %s but get %s", `{"Day":"1403-07-15"}`, data)
+		}
+
+		var result event
+		if err := json.Unmarshal(data, &result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Day.Time != date {
+			t.Errorf("Expect %s but get %s", date, result.Day.Time)
+		}
+
+		if data, _ := json.Marshal(gojalaali.JSONLayout[gojalaali.DateTimeLayout]{}); string(data) != "null" {
+			t.Errorf("Expect null but get %s", data)
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	date := gojalaali.Date(1403, 01, 15, 20, 14, 0, 120, gojalaali.TehranTz())
+	data, err := date.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "1403-01-15T20:14:00.000000120+03:30"
+	if expected != string(data) {
+		t.Errorf("Expect %s but get %s", expected, data)
+	}
+
+	result := gojalaali.New(time.Time{})
+	if err := result.UnmarshalText(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.UnixNano() != date.UnixNano() {
+		t.Errorf("Expect %s but get %s", date, result)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	dates := []gojalaali.Jalaali{
+		gojalaali.Date(1403, 12, 30, 23, 59, 59, 999999999, gojalaali.KabulTz()),
+		gojalaali.Date(1403, 01, 15, 20, 14, 0, 0, time.UTC),
+		gojalaali.Date(1403, 01, 15, 20, 14, 0, 0, time.FixedZone("", -18000)),
+		gojalaali.New(time.Time{}),
+	}
+
+	for _, date := range dates {
+		data, err := date.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		result := gojalaali.Now()
+		if err := result.UnmarshalBinary(data); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if date.IsZero() != result.IsZero() {
+			t.Errorf("Expect zero %v but get %v", date.IsZero(), result.IsZero())
+		}
+		if date.Format(time.RFC3339Nano+" January MST") != result.Format(time.RFC3339Nano+" January MST") {
+			t.Errorf("Expect %s but get %s", date, result)
+		}
+	}
+
+	if err := gojalaali.New(time.Time{}).UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("Expect error for invalid data")
+	}
+}
+
+func TestGob(t *testing.T) {
+	date := gojalaali.Date(1403, 01, 15, 20, 14, 0, 10, gojalaali.TehranTz())
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(date); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := gojalaali.New(time.Time{})
+	if err := gob.NewDecoder(&buf).Decode(result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if date.String() != result.String() || date.UnixNano() != result.UnixNano() {
+		t.Errorf("Expect %s but get %s", date, result)
+	}
+}
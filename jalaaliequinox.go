@@ -0,0 +1,52 @@
+package gojalaali
+
+import (
+	"math"
+	"time"
+)
+
+// nowruzZone is the official meridian (52.5°E) used by noon rule.
+var nowruzZone = time.FixedZone("IRST", 12600)
+
+// Equinox returns the instant of march equinox (Tahvil-e Sal)
+// starting the jalaali year in loc using Meeus algorithm.
+// Local is used if loc is nil.
+func Equinox(year int, loc *time.Location) Jalaali {
+	if loc == nil {
+		loc = time.Local
+	}
+	return New(equinox(year).In(loc))
+}
+
+// NowruzDay returns the beginning of the day which is 1 Farvardin of year under
+// the noon rule: if equinox happens before noon in Tehran meridian, that day
+// starts the year, otherwise the next day. ok reports whether the result is
+// 1 Farvardin of year under ArithmeticRule.
+func NowruzDay(year int) (day Jalaali, ok bool) {
+	return NowruzDayWithRule(year, nil)
+}
+
+// NowruzDayWithRule is like NowruzDay but the result uses rule
+// and ok reports whether it is 1 Farvardin of year under rule.
+// ArithmeticRule is used if rule is nil.
+func NowruzDayWithRule(year int, rule CalendarRule) (day Jalaali, ok bool) {
+	t := equinox(year).In(nowruzZone)
+	if t.Hour() >= 12 {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	day = NewWithRule(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, nowruzZone), rule)
+	y, m, d := day.Date()
+	return day, y == year && m == Farvardin && d == 1
+}
+
+// equinox returns the march equinox instant of jalaali year.
+func equinox(year int) time.Time {
+	gy := year + 621
+	jde := marchEquinoxJDE(gy)
+	jd := jde - deltaT(float64(gy)+0.2)/86400
+
+	secs := (jd - unixEpochJulianDay) * 86400
+	sec, frac := math.Modf(secs)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
@@ -0,0 +1,54 @@
+package gojalaali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestEquinox(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected time.Time
+	}{
+		{1395, time.Date(2016, 3, 20, 4, 30, 11, 0, time.UTC)},
+		{1400, time.Date(2021, 3, 20, 9, 37, 27, 0, time.UTC)},
+		{1403, time.Date(2024, 3, 20, 3, 6, 21, 0, time.UTC)},
+		{1404, time.Date(2025, 3, 20, 9, 1, 25, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		res := gojalaali.Equinox(test.year, gojalaali.TehranTz())
+		if res.Location().String() != gojalaali.TehranTz().String() {
+			t.Errorf("Expect Tehran location, got %s", res.Location())
+		}
+		if diff := res.Time().Sub(test.expected).Abs(); diff > 30*time.Second {
+			t.Errorf("Expect %s for %d, got %s", test.expected, test.year, res.Time().UTC())
+		}
+	}
+
+	res := gojalaali.Equinox(1403, nil)
+	if res.Location() != time.Local {
+		t.Errorf("Expect Local location, got %s", res.Location())
+	}
+}
+
+func TestNowruzDay(t *testing.T) {
+	tests := []struct {
+		rule     gojalaali.CalendarRule
+		year     int
+		expected string
+		ok       bool
+	}{
+		{gojalaali.ArithmeticRule{}, 1403, "1403-01-01", true}, // Before noon
+		{gojalaali.ArithmeticRule{}, 1404, "1404-01-01", true}, // After noon
+		{gojalaali.ArithmeticRule{}, 1177, "1177-01-02", false},
+		{gojalaali.BorkowskiRule{}, 1177, "1177-01-01", true},
+	}
+	for _, test := range tests {
+		day, ok := gojalaali.NowruzDayWithRule(test.year, test.rule)
+		if day.Format("2006-01-02") != test.expected || ok != test.ok {
+			t.Errorf("%T: expect %s (%v) for %d, got %s (%v)", test.rule, test.expected, test.ok, test.year, day.Format("2006-01-02"), ok)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package gojalaali
+
+import (
+	"errors"
+	"fmt"
+)
+
+// List of errors returned by Parse and ParseInLocation.
+// Use errors.Is to check the reason of a *ParseError.
+var (
+	ErrEmptyLayout      = errors.New("layout cannot be empty")
+	ErrEmptyValue       = errors.New("datetime cannot be empty")
+	ErrInvalidLayout    = errors.New("invalid layout")
+	ErrLayoutMismatch   = errors.New("input does not match layout")
+	ErrMonthOutOfRange  = errors.New("month out of range")
+	ErrDayOutOfRange    = errors.New("day out of range for month")
+	ErrHourOutOfRange   = errors.New("hour out of range")
+	ErrMinuteOutOfRange = errors.New("minute out of range")
+	ErrSecondOutOfRange = errors.New("second out of range")
+	ErrUnknownZone      = errors.New("unknown time zone")
+	ErrWeekdayMismatch  = errors.New("weekday does not match date")
+	ErrSeasonMismatch   = errors.New("season does not match month")
+
+	// ErrNonLeapYear is returned for 30 Esfand in a non-leap year.
+	// It wraps ErrDayOutOfRange.
+	ErrNonLeapYear = fmt.Errorf("%w: 30 esfand in non-leap year", ErrDayOutOfRange)
+)
+
+// ParseError describes a problem parsing a jalaali datetime string.
+type ParseError struct {
+	Layout    string // Layout passed to parse
+	Value     string // Value passed to parse
+	Element   string // Offending element name like "month", empty if not specific
+	ValueElem string // Offending part of value, empty if not specific
+	Err       error  // Reason of error
+}
+
+// newParseError create a new *ParseError.
+func newParseError(layout, value, element, valueElem string, err error) *ParseError {
+	return &ParseError{
+		Layout:    layout,
+		Value:     value,
+		Element:   element,
+		ValueElem: valueElem,
+		Err:       err,
+	}
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	if e.Element == "" {
+		return fmt.Sprintf("parsing jalaali %q as %q: %v", e.Value, e.Layout, e.Err)
+	}
+	return fmt.Sprintf(
+		"parsing jalaali %q as %q: invalid %s %q: %v",
+		e.Value, e.Layout, e.Element, e.ValueElem, e.Err,
+	)
+}
+
+// Unwrap returns the reason of error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
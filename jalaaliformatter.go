@@ -92,14 +92,28 @@ func (jt jTime) formatRFC3339(isNano bool) string {
 }
 
 func (jt jTime) Format(layout string) string {
+	return jt.format(layout, '0')
+}
+
+func (jt jTime) FormatFa(layout string) string {
+	return jt.format(layout, '۰')
+}
+
+func (jt jTime) FormatAr(layout string) string {
+	return jt.format(layout, '٠')
+}
+
+// format formats layout and writes numeric parts
+// with digits started from zero rune.
+func (jt jTime) format(layout string, zero rune) string {
 	// Quick Format RFC3339 and RFC3339Nano
 	if layout == time.RFC3339 || layout == time.RFC3339Nano {
-		return jt.formatRFC3339(layout == time.RFC3339Nano)
+		return localizeDigits(jt.formatRFC3339(layout == time.RFC3339Nano), zero)
 	}
 
 	// Format layout
 	isDari := jt.Location().String() == KabulTz().String()
-	return strings.NewReplacer(
+	pairs := []string{
 		// Year
 		"2006", formatYear(jt.year, 4),
 		"06", formatYear(jt.year, 2),
@@ -133,6 +147,9 @@ func (jt jTime) Format(layout string) string {
 		".000000000", formatFractional(jt.nsec, 9, false),
 		".000000", formatFractional(jt.nsec, 6, false),
 		".000", formatFractional(jt.nsec, 3, false),
+		// Season
+		"Spring", formatSeason(jt.Season(), isDari),
+		"QQ", fmt.Sprintf("%d", jt.Quarter()),
 		// Daytime
 		"Morning", jt.DayTime().String(),
 		"PM", jt.AmPm().String(),
@@ -149,7 +166,15 @@ func (jt jTime) Format(layout string) string {
 		"-07:00:00", jt.formatOffset("-07:00:00"),
 		"-07:00", jt.formatOffset("-07:00"),
 		"-07", jt.formatOffset("-07"),
-	).Replace(layout)
+	}
+
+	// Localize digits of formatted parts only
+	if zero != '0' {
+		for i := 1; i < len(pairs); i += 2 {
+			pairs[i] = localizeDigits(pairs[i], zero)
+		}
+	}
+	return strings.NewReplacer(pairs...).Replace(layout)
 }
 
 // Helpers
@@ -180,6 +205,19 @@ func formatFractional(value, length int, trimmed bool) string {
 	return str
 }
 
+// localizeDigits replace ascii digits with digits started from zero rune.
+func localizeDigits(value string, zero rune) string {
+	if zero == '0' {
+		return value
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return zero + (r - '0')
+		}
+		return r
+	}, value)
+}
+
 func formatYear(year, length int) string {
 	str := fmt.Sprintf("%4d", year)
 	if length == 4 {
@@ -190,6 +228,13 @@ func formatYear(year, length int) string {
 
 }
 
+func formatSeason(season Season, isDari bool) string {
+	if isDari {
+		return season.Dari()
+	}
+	return season.String()
+}
+
 func formatMonth(month Month, isShort, isDari bool) string {
 	if isDari && isShort {
 		return month.DariShort()
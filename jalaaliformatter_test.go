@@ -2,6 +2,7 @@ package gojalaali_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mekramy/gojalaali"
 )
@@ -157,3 +158,51 @@ func TestZoneFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestDigitsFormat(t *testing.T) {
+	tests := []struct {
+		layout  string
+		persian string
+		arabic  string
+	}{
+		{"2006/01/02", "۱۴۰۳/۰۷/۰۵", "١٤٠٣/٠٧/٠٥"},
+		{"_2 January 06", " ۵ مهر ۰۳", " ٥ مهر ٠٣"},
+		{"15:04:05.999 -07:00", "۰۸:۳۰:۱۵.۱۲ +۰۳:۳۰", "٠٨:٣٠:١٥.١٢ +٠٣:٣٠"},
+		{"Day 2 of 1", "Day ۵ of ۷", "Day ٥ of ٧"},
+		{time.RFC3339, "۱۴۰۳-۰۷-۰۵T۰۸:۳۰:۱۵+۰۳:۳۰", "١٤٠٣-٠٧-٠٥T٠٨:٣٠:١٥+٠٣:٣٠"},
+	}
+
+	date := gojalaali.Date(1403, gojalaali.Mehr, 5, 8, 30, 15, 120000000, gojalaali.TehranTz())
+	for _, test := range tests {
+		if formatted := date.FormatFa(test.layout); formatted != test.persian {
+			t.Errorf("fail %s, expected %s, got %s", test.layout, test.persian, formatted)
+		}
+		if formatted := date.FormatAr(test.layout); formatted != test.arabic {
+			t.Errorf("fail %s, expected %s, got %s", test.layout, test.arabic, formatted)
+		}
+	}
+}
+
+func TestSeasonFormat(t *testing.T) {
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{"Spring", "پاییز"},
+		{"QQ", "3"},
+		{"Spring 2006 (QQ)", "پاییز 1403 (3)"},
+		{"Quarter QQ 2006", "Quarter 3 1403"}, // Literal Q
+	}
+
+	date := gojalaali.Date(1403, gojalaali.Aban, 3, 0, 0, 0, 0, gojalaali.TehranTz())
+	for _, test := range tests {
+		formatted := date.Format(test.layout)
+		if formatted != test.expected {
+			t.Errorf("fail %s, expected %s, got %s", test.layout, test.expected, formatted)
+		}
+	}
+
+	if formatted := date.In(gojalaali.KabulTz()).Format("Spring"); formatted != "خزان" {
+		t.Errorf("fail Spring, expected %s, got %s", "خزان", formatted)
+	}
+}
@@ -0,0 +1,71 @@
+package gojalaali
+
+import "time"
+
+// GridDay represents a cell of month grid.
+type GridDay struct {
+	Date           JDate
+	Weekday        Weekday
+	InMonth        bool // False for leading and trailing days of adjacent months
+	Today          bool // True for the current date in location of grid
+	Weekend        bool
+	Holiday        bool     // Weekend or named holiday
+	Holidays       []string // Names of holidays
+	GregorianYear  int
+	GregorianMonth time.Month
+	GregorianDay   int
+	HijriMonth     HijriMonth
+	HijriDay       int
+}
+
+// Grid represents the 6x7 calendar grid of a jalaali month.
+// Each week starts from Shanbeh and includes days of adjacent months.
+type Grid struct {
+	Year  int
+	Month Month
+	Weeks [6][7]GridDay
+}
+
+// MonthGrid returns the calendar grid of month with weekend and holiday flags of hc.
+// NewIranHolidayCalendar is used if hc is nil, pass NewHolidayCalendar for no holidays.
+// Today is detected in loc and local time is used if loc is nil.
+// Hijri dates are converted using conv and TabularHijri is used if conv is nil.
+func MonthGrid(year int, month Month, loc *time.Location, hc *HolidayCalendar, conv HijriConverter) Grid {
+	if hc == nil {
+		hc = NewIranHolidayCalendar()
+	}
+	conv = hijriConverter(conv)
+
+	first := NewJDate(year, month, 1)
+	start := first.AddDays(-int(first.Weekday()))
+	today := Today(loc)
+	weekend := hc.weekendSet()
+	cache := make(map[int][]Holiday)
+
+	grid := Grid{Year: first.Year, Month: first.Month}
+	for i := range 42 {
+		date := start.AddDays(i)
+		jdn := date.jdn()
+		gYear, gMonth, gDay := convertJDNToGregorian(jdn)
+		hijri := conv.FromJDN(jdn)
+
+		day := GridDay{
+			Date:           date,
+			Weekday:        date.Weekday(),
+			InMonth:        date.Year == first.Year && date.Month == first.Month,
+			Today:          date == today,
+			Weekend:        weekend[date.Weekday()],
+			GregorianYear:  gYear,
+			GregorianMonth: time.Month(gMonth),
+			GregorianDay:   gDay,
+			HijriMonth:     hijri.Month,
+			HijriDay:       hijri.Day,
+		}
+		for _, h := range hc.holidaysOf(date.Jalaali(time.UTC), cache) {
+			day.Holidays = append(day.Holidays, h.Name)
+		}
+		day.Holiday = day.Weekend || len(day.Holidays) > 0
+		grid.Weeks[i/7][i%7] = day
+	}
+	return grid
+}
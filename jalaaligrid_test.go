@@ -0,0 +1,163 @@
+package gojalaali_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestMonthGrid(t *testing.T) {
+	t.Run("Layout", func(t *testing.T) {
+		grid := gojalaali.MonthGrid(1403, gojalaali.Farvardin, nil, gojalaali.NewHolidayCalendar(), nil)
+		if grid.Year != 1403 || grid.Month != gojalaali.Farvardin {
+			t.Errorf("Unexpected grid month %d/%d", grid.Year, grid.Month)
+		}
+
+		tests := []struct {
+			week, weekday int
+			date          string
+			inMonth       bool
+			gregorian     string
+			hijri         int
+		}{
+			{0, 0, "1402-12-26", false, "2024-03-16", 6},
+			{0, 4, "1403-01-01", true, "2024-03-20", 10}, // Chaharshanbeh
+			{4, 6, "1403-01-31", true, "2024-04-19", 10},
+			{5, 0, "1403-02-01", false, "2024-04-20", 11},
+			{5, 6, "1403-02-07", false, "2024-04-26", 17},
+		}
+		for _, test := range tests {
+			day := grid.Weeks[test.week][test.weekday]
+			if day.Date.String() != test.date || day.InMonth != test.inMonth {
+				t.Errorf("Expect %s (%v) at %d:%d, got %s (%v)", test.date, test.inMonth, test.week, test.weekday, day.Date, day.InMonth)
+			}
+			if day.Weekday != gojalaali.Weekday(test.weekday) {
+				t.Errorf("Expect weekday %d for %s, got %d", test.weekday, test.date, day.Weekday)
+			}
+			gregorian := fmt.Sprintf("%04d-%02d-%02d", day.GregorianYear, day.GregorianMonth, day.GregorianDay)
+			if gregorian != test.gregorian || day.HijriDay != test.hijri {
+				t.Errorf("Expect gregorian %s and hijri %d for %s, got %s and %d", test.gregorian, test.hijri, test.date, gregorian, day.HijriDay)
+			}
+			if day.Holiday || day.Weekend {
+				t.Errorf("Expect no holiday with empty calendar for %s", test.date)
+			}
+		}
+
+		// Normalized month
+		if res := gojalaali.MonthGrid(1403, 13, nil, nil, nil); res.Year != 1404 || res.Month != gojalaali.Farvardin {
+			t.Errorf("Expect 1404/01 grid, got %d/%d", res.Year, res.Month)
+		}
+	})
+
+	t.Run("Holidays", func(t *testing.T) {
+		// Iran holiday calendar is used by default
+		grid := gojalaali.MonthGrid(1403, gojalaali.Farvardin, nil, nil, nil)
+		tests := []struct {
+			week, weekday int
+			weekend       bool
+			holidays      string
+		}{
+			{0, 2, false, ""},
+			{0, 3, false, "ملی شدن صنعت نفت"}, // Leading day
+			{0, 4, false, "نوروز"},
+			{0, 6, true, "نوروز"},
+			{1, 6, true, ""},
+			{2, 1, false, "روز جمهوری اسلامی"},
+		}
+		for _, test := range tests {
+			day := grid.Weeks[test.week][test.weekday]
+			if day.Weekend != test.weekend || strings.Join(day.Holidays, ",") != test.holidays {
+				t.Errorf("Expect %v %q for %s, got %v %q", test.weekend, test.holidays, day.Date, day.Weekend, day.Holidays)
+			}
+			if day.Holiday != (test.weekend || test.holidays != "") {
+				t.Errorf("Unexpected holiday flag for %s", day.Date)
+			}
+		}
+	})
+
+	t.Run("GregorianYear", func(t *testing.T) {
+		// 1403/10/11 is 2024-12-31 and 1403/10/12 is 2025-01-01
+		grid := gojalaali.MonthGrid(1403, gojalaali.Dey, nil, nil, nil)
+		for _, week := range grid.Weeks {
+			for _, day := range week {
+				expected := 2024
+				if day.Date.After(gojalaali.JDate{Year: 1403, Month: gojalaali.Dey, Day: 11}) {
+					expected = 2025
+				}
+				if day.GregorianYear != expected {
+					t.Errorf("Expect gregorian year %d for %s, got %d", expected, day.Date, day.GregorianYear)
+				}
+			}
+		}
+	})
+
+	t.Run("Today", func(t *testing.T) {
+		// Zones 26 hours apart are always on different dates
+		east := time.FixedZone("East", 14*3600)
+		west := time.FixedZone("West", -12*3600)
+		for _, loc := range []*time.Location{nil, east, west} {
+			today := gojalaali.Today(loc)
+			grid := gojalaali.MonthGrid(today.Year, today.Month, loc, nil, nil)
+
+			var res []gojalaali.JDate
+			for _, week := range grid.Weeks {
+				for _, day := range week {
+					if day.Today {
+						res = append(res, day.Date)
+					}
+				}
+			}
+			if len(res) != 1 || res[0] != today {
+				t.Errorf("Expect today %s in %v, got %v", today, loc, res)
+			}
+		}
+	})
+
+	t.Run("Hijri", func(t *testing.T) {
+		table := gojalaali.NewHijriTable(
+			1446, gojalaali.Date(1403, 4, 17, 0, 0, 0, 0, gojalaali.TehranTz()),
+			[12]int{30, 29, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29},
+		)
+
+		// 1403/04/26 is 10 Muharram in table and 9 Muharram in tabular calendar
+		tests := []struct {
+			calendar *gojalaali.HolidayCalendar
+			conv     gojalaali.HijriConverter
+			hijri    int
+			holidays string
+		}{
+			{gojalaali.NewHolidayCalendar().AddProvider(gojalaali.NewIranLunarHolidays(table)), table, 10, "عاشورای حسینی"},
+			{gojalaali.NewHolidayCalendar(), table, 10, ""},
+			{gojalaali.NewHolidayCalendar(), nil, 9, ""},
+		}
+		for _, test := range tests {
+			day := gojalaali.MonthGrid(1403, gojalaali.Tir, nil, test.calendar, test.conv).Weeks[4][3]
+			if day.Date.String() != "1403-04-26" || day.HijriDay != test.hijri || day.HijriMonth != gojalaali.Muharram {
+				t.Errorf("Expect %d Muharram on %s, got %d/%d", test.hijri, day.Date, day.HijriDay, day.HijriMonth)
+			}
+			if strings.Join(day.Holidays, ",") != test.holidays {
+				t.Errorf("Expect %q on %s, got %q", test.holidays, day.Date, day.Holidays)
+			}
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		grid := gojalaali.MonthGrid(1403, gojalaali.Farvardin, nil, nil, nil)
+		data, err := json.Marshal(grid.Weeks[0][4])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var res map[string]any
+		if err := json.Unmarshal(data, &res); err != nil {
+			t.Fatal(err)
+		}
+		if res["Date"] != "1403-01-01" || res["GregorianMonth"] != float64(time.March) || res["HijriMonth"] != float64(gojalaali.Ramadan) {
+			t.Errorf("Unexpected json %s", data)
+		}
+	})
+}
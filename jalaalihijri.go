@@ -0,0 +1,177 @@
+package gojalaali
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// hijriEpoch is the julian day number of 1 Muharram 1 in civil tabular calendar.
+const hijriEpoch = 1948440
+
+// Hijri represents a date in hijri qamari (lunar) calendar.
+// Hijri is comparable and does not keep the converter used to create it.
+type Hijri struct {
+	Year  int
+	Month HijriMonth
+	Day   int
+}
+
+// HijriConverter converts between julian day number and hijri date.
+// It can be used to plug official or observation based lunar calendars.
+type HijriConverter interface {
+	FromJDN(jdn int) Hijri
+	ToJDN(h Hijri) int
+}
+
+// TabularHijri is the arithmetical (civil) islamic calendar with
+// 30 years cycle and leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29.
+type TabularHijri struct{}
+
+func (TabularHijri) ToJDN(h Hijri) int {
+	return h.Day + (59*(int(h.Month)-1)+1)/2 +
+		(h.Year-1)*354 + floorDiv(3+11*h.Year, 30) + hijriEpoch - 1
+}
+
+func (t TabularHijri) FromJDN(jdn int) Hijri {
+	year := floorDiv(30*(jdn-hijriEpoch)+10646, 10631)
+
+	// ceil((jdn - first day of year - 29) / 29.5) + 1
+	first := t.ToJDN(Hijri{Year: year, Month: Muharram, Day: 1})
+	month := -floorDiv(-2*(jdn-first-29), 59) + 1
+	if month > 12 {
+		month = 12
+	}
+
+	day := jdn - t.ToJDN(Hijri{Year: year, Month: HijriMonth(month), Day: 1}) + 1
+	return Hijri{Year: year, Month: HijriMonth(month), Day: day}
+}
+
+// HijriTable converts using a table of month lengths like the official
+// iranian lunar calendar. Dates out of table range are converted using
+// TabularHijri.
+type HijriTable struct {
+	year   int
+	start  int
+	months [][12]int
+}
+
+// NewHijriTable create a new hijri table starting from 1 Muharram of year
+// which is on the date of first. Each item of months contains the lengths
+// of months of a year.
+func NewHijriTable(year int, first Jalaali, months ...[12]int) *HijriTable {
+	return &HijriTable{
+		year:   year,
+		start:  jdnOf(first),
+		months: months,
+	}
+}
+
+func (ht *HijriTable) ToJDN(h Hijri) int {
+	index := h.Year - ht.year
+	if index < 0 || index >= len(ht.months) || h.Month < Muharram || h.Month > DhuAlHijjah {
+		return TabularHijri{}.ToJDN(h)
+	}
+
+	jdn := ht.start
+	for _, year := range ht.months[:index] {
+		for _, days := range year {
+			jdn += days
+		}
+	}
+	for _, days := range ht.months[index][:h.Month-1] {
+		jdn += days
+	}
+	return jdn + h.Day - 1
+}
+
+func (ht *HijriTable) FromJDN(jdn int) Hijri {
+	if jdn >= ht.start {
+		remain := jdn - ht.start
+		for y, year := range ht.months {
+			for m, days := range year {
+				if remain < days {
+					return Hijri{Year: ht.year + y, Month: HijriMonth(m + 1), Day: remain + 1}
+				}
+				remain -= days
+			}
+		}
+	}
+	return TabularHijri{}.FromJDN(jdn)
+}
+
+// ToHijri convert the date of j to hijri using conv.
+// TabularHijri is used if conv is nil.
+func ToHijri(j Jalaali, conv HijriConverter) Hijri {
+	return hijriConverter(conv).FromJDN(jdnOf(j))
+}
+
+// FromHijri create a new instance at beginning of the hijri date in loc using conv.
+// TabularHijri is used if conv is nil. The result uses ArithmeticRule,
+// use NewWithRule with its Time for other rules.
+func FromHijri(h Hijri, loc *time.Location, conv HijriConverter) Jalaali {
+	year, month, day := convertJDNToShamsi(hijriConverter(conv).ToJDN(h))
+	return Date(year, Month(month), day, 0, 0, 0, 0, loc)
+}
+
+func (jt jTime) Hijri() Hijri {
+	return TabularHijri{}.FromJDN(jt.jdn())
+}
+
+// hijriConverter returns conv or TabularHijri if conv is nil.
+func hijriConverter(conv HijriConverter) HijriConverter {
+	if conv == nil {
+		return TabularHijri{}
+	}
+	return conv
+}
+
+// Weekday returns the day of week of hijri date using conv.
+// Pass the converter used to create h. TabularHijri is used if conv is nil.
+func (h Hijri) Weekday(conv HijriConverter) Weekday {
+	return jdnWeekday(hijriConverter(conv).ToJDN(h))
+}
+
+// String returns the hijri date in 2006-01-02 format.
+func (h Hijri) String() string {
+	return h.Format("2006-01-02", nil)
+}
+
+// Format returns a textual representation of the hijri date.
+// Supported layout parts are year, month, day and weekday parts of Jalaali Format.
+// Month and weekday names are in Arabic. Weekday is calculated using conv
+// and TabularHijri is used if conv is nil.
+func (h Hijri) Format(layout string, conv HijriConverter) string {
+	return h.format(layout, conv, '0')
+}
+
+// FormatAr is like Format but writes digits in Arabic-Indic.
+func (h Hijri) FormatAr(layout string, conv HijriConverter) string {
+	return h.format(layout, conv, '٠')
+}
+
+func (h Hijri) format(layout string, conv HijriConverter, zero rune) string {
+	pairs := []string{
+		// Year
+		"2006", formatYear(h.Year, 4),
+		"06", formatYear(h.Year, 2),
+		// Month
+		"January", h.Month.String(),
+		"01", fmt.Sprintf("%02d", h.Month),
+		"1", fmt.Sprintf("%d", h.Month),
+		// Day
+		"02", fmt.Sprintf("%02d", h.Day),
+		"_2", fmt.Sprintf("%2d", h.Day),
+		"2", fmt.Sprintf("%d", h.Day),
+		// Weekday
+		"Monday", h.Weekday(conv).Arabic(),
+	}
+
+	// Localize digits of formatted parts only
+	if zero != '0' {
+		for i := 1; i < len(pairs); i += 2 {
+			pairs[i] = localizeDigits(pairs[i], zero)
+		}
+	}
+	return strings.NewReplacer(pairs...).Replace(layout)
+}
@@ -0,0 +1,137 @@
+package gojalaali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestHijri(t *testing.T) {
+	tz := gojalaali.TehranTz()
+
+	t.Run("Tabular", func(t *testing.T) {
+		tests := []struct {
+			date     gojalaali.Jalaali
+			expected gojalaali.Hijri
+		}{
+			{gojalaali.Date(1403, 12, 11, 10, 0, 0, 0, tz), gojalaali.Hijri{Year: 1446, Month: gojalaali.Ramadan, Day: 1}},
+			{gojalaali.Date(1404, 1, 10, 10, 0, 0, 0, tz), gojalaali.Hijri{Year: 1446, Month: gojalaali.Ramadan, Day: 30}},
+			{gojalaali.Date(1403, 4, 17, 10, 0, 0, 0, tz), gojalaali.Hijri{Year: 1445, Month: gojalaali.DhuAlHijjah, Day: 30}},
+			{gojalaali.Date(1403, 4, 18, 10, 0, 0, 0, tz), gojalaali.Hijri{Year: 1446, Month: gojalaali.Muharram, Day: 1}},
+		}
+		for _, test := range tests {
+			res := test.date.Hijri()
+			if res != test.expected {
+				t.Errorf("Expect %s for %s, got %s", test.expected, test.date, res)
+			}
+
+			back := gojalaali.FromHijri(res, tz, nil)
+			if back.Format("2006-01-02") != test.date.Format("2006-01-02") {
+				t.Errorf("Expect %s from %s, got %s", test.date, res, back)
+			}
+		}
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		table := gojalaali.NewHijriTable(
+			1446, gojalaali.Date(1403, 4, 17, 0, 0, 0, 0, tz),
+			[12]int{30, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29, 30},
+		)
+
+		tests := []struct {
+			date     gojalaali.Jalaali
+			expected gojalaali.Hijri
+		}{
+			{gojalaali.Date(1403, 4, 17, 0, 0, 0, 0, tz), gojalaali.Hijri{Year: 1446, Month: gojalaali.Muharram, Day: 1}},
+			{gojalaali.Date(1403, 5, 15, 0, 0, 0, 0, tz), gojalaali.Hijri{Year: 1446, Month: gojalaali.Muharram, Day: 30}},
+			{gojalaali.Date(1403, 5, 16, 0, 0, 0, 0, tz), gojalaali.Hijri{Year: 1446, Month: gojalaali.Safar, Day: 1}},
+			{gojalaali.Date(1403, 4, 16, 0, 0, 0, 0, tz), gojalaali.Hijri{Year: 1445, Month: gojalaali.DhuAlHijjah, Day: 29}}, // Tabular fallback
+		}
+		for _, test := range tests {
+			res := gojalaali.ToHijri(test.date, table)
+			if res != test.expected {
+				t.Errorf("Expect %s for %s, got %s", test.expected, test.date, res)
+			}
+
+			back := gojalaali.FromHijri(res, tz, table)
+			if back.Format("2006-01-02") != test.date.Format("2006-01-02") {
+				t.Errorf("Expect %s from %s, got %s", test.date, res, back)
+			}
+		}
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		h := gojalaali.Hijri{Year: 1446, Month: gojalaali.Ramadan, Day: 1}
+		tests := []struct {
+			layout   string
+			expected string
+			arabic   bool
+		}{
+			{"2006-01-02", "1446-09-01", false},
+			{"Monday _2 January 2006", "السبت  1 رمضان 1446", false},
+			{"2 January 06", "١ رمضان ٤٦", true},
+		}
+		for _, test := range tests {
+			res := h.Format(test.layout, nil)
+			if test.arabic {
+				res = h.FormatAr(test.layout, nil)
+			}
+			if res != test.expected {
+				t.Errorf("Expect %q for %q, got %q", test.expected, test.layout, res)
+			}
+		}
+	})
+
+	t.Run("TableWeekday", func(t *testing.T) {
+		// Table starts one day after tabular calendar
+		table := gojalaali.NewHijriTable(
+			1446, gojalaali.Date(1403, 4, 19, 0, 0, 0, 0, tz),
+			[12]int{30, 29, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29},
+		)
+
+		date := gojalaali.Date(1403, 5, 1, 0, 0, 0, 0, tz)
+		h := gojalaali.ToHijri(date, table)
+		if h != (gojalaali.Hijri{Year: 1446, Month: gojalaali.Muharram, Day: 14}) {
+			t.Errorf("Expect 1446-01-14, got %s", h)
+		}
+		if h.Weekday(table) != date.Weekday() || h.Weekday(table) != gojalaali.Doshanbeh {
+			t.Errorf("Expect %s to be %s, got %s", h, date.Weekday(), h.Weekday(table))
+		}
+		if res := h.Format("Monday 2 January", table); res != "الاثنين 14 محرم" {
+			t.Errorf("Expect %q, got %q", "الاثنين 14 محرم", res)
+		}
+
+		// Table start is the day of first regardless of its rule
+		first := gojalaali.DateWithRule(1177, 1, 1, 0, 0, 0, 0, tz, gojalaali.BorkowskiRule{})
+		table = gojalaali.NewHijriTable(1212, first, [12]int{30, 29, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29})
+		if res := gojalaali.ToHijri(first, table); res.String() != "1212-01-01" {
+			t.Errorf("Expect 1212-01-01, got %s", res)
+		}
+	})
+
+	t.Run("LunarHolidays", func(t *testing.T) {
+		table := gojalaali.NewHijriTable(
+			1446, gojalaali.Date(1403, 4, 17, 0, 0, 0, 0, tz),
+			[12]int{30, 29, 30, 29, 30, 29, 30, 29, 30, 29, 30, 29},
+		)
+
+		tests := []struct {
+			conv     gojalaali.HijriConverter
+			date     gojalaali.Jalaali
+			expected string
+		}{
+			{nil, gojalaali.Date(1403, 4, 27, 0, 0, 0, 0, time.UTC), "عاشورای حسینی"},
+			{table, gojalaali.Date(1403, 4, 26, 0, 0, 0, 0, time.UTC), "عاشورای حسینی"},
+			{table, gojalaali.Date(1403, 6, 13, 0, 0, 0, 0, time.UTC), "شهادت امام رضا"}, // 30 Safar clamped to 29
+		}
+		for _, test := range tests {
+			calendar := gojalaali.NewHolidayCalendar().
+				AddProvider(gojalaali.NewIranLunarHolidays(test.conv))
+			holidays := calendar.Holidays(test.date)
+			if len(holidays) != 1 || holidays[0].Name != test.expected {
+				t.Errorf("Expect %s on %s, got %v", test.expected, test.date, holidays)
+			}
+		}
+	})
+}
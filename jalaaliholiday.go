@@ -0,0 +1,335 @@
+package gojalaali
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrNoWorkdays is returned by AddWorkdays when calendar
+// has no workday, like when all weekdays are weekends.
+var ErrNoWorkdays = errors.New("calendar has no workdays")
+
+// maxHolidayRun is the max number of consecutive non-workdays
+// AddWorkdays walks before giving up (a year of holidays and a week of weekends).
+const maxHolidayRun = 366 + 7
+
+// Holiday represents a named holiday in jalaali calendar.
+type Holiday struct {
+	Name  string
+	Year  int // Zero for holidays recurring every year
+	Month Month
+	Day   int
+}
+
+// HolidayProvider provides holidays of a jalaali year.
+// It can be used to plug holidays that are not fixed
+// in jalaali calendar like lunar holidays.
+type HolidayProvider interface {
+	Holidays(year int) []Holiday
+}
+
+// HolidayFunc is an adapter to allow the use of
+// ordinary functions as HolidayProvider.
+type HolidayFunc func(year int) []Holiday
+
+// Holidays calls f(year).
+func (f HolidayFunc) Holidays(year int) []Holiday {
+	return f(year)
+}
+
+// iranFixedHolidays is the list of official iranian solar holidays.
+var iranFixedHolidays = []Holiday{
+	{Name: "نوروز", Month: Farvardin, Day: 1},
+	{Name: "نوروز", Month: Farvardin, Day: 2},
+	{Name: "نوروز", Month: Farvardin, Day: 3},
+	{Name: "نوروز", Month: Farvardin, Day: 4},
+	{Name: "روز جمهوری اسلامی", Month: Farvardin, Day: 12},
+	{Name: "روز طبیعت", Month: Farvardin, Day: 13},
+	{Name: "رحلت امام خمینی", Month: Khordad, Day: 14},
+	{Name: "قیام ۱۵ خرداد", Month: Khordad, Day: 15},
+	{Name: "پیروزی انقلاب اسلامی", Month: Bahman, Day: 22},
+	{Name: "ملی شدن صنعت نفت", Month: Esfand, Day: 29},
+}
+
+// HolidayCalendar represents a calendar of weekends and holidays
+// used for business-day arithmetic.
+// HolidayCalendar is not safe for concurrent modification.
+type HolidayCalendar struct {
+	weekends  []Weekday
+	holidays  []Holiday
+	providers []HolidayProvider
+}
+
+// NewHolidayCalendar create a new empty holiday calendar with given weekends.
+func NewHolidayCalendar(weekends ...Weekday) *HolidayCalendar {
+	return &HolidayCalendar{
+		weekends: slices.Clone(weekends),
+	}
+}
+
+// NewIranHolidayCalendar create a new holiday calendar with Jomeh
+// as weekend and official iranian fixed solar holidays.
+// Lunar holidays can be added using AddProvider and NewIranLunarHolidays.
+func NewIranHolidayCalendar() *HolidayCalendar {
+	return &HolidayCalendar{
+		weekends: []Weekday{Jomeh},
+		holidays: slices.Clone(iranFixedHolidays),
+	}
+}
+
+// SetWeekends replace weekends of calendar.
+func (hc *HolidayCalendar) SetWeekends(weekends ...Weekday) *HolidayCalendar {
+	hc.weekends = slices.Clone(weekends)
+	return hc
+}
+
+// AddRecurring add a holiday recurring every year on month and day.
+func (hc *HolidayCalendar) AddRecurring(month Month, day int, name string) *HolidayCalendar {
+	hc.holidays = append(hc.holidays, Holiday{Name: name, Month: month, Day: day})
+	return hc
+}
+
+// AddHoliday add a holiday on the date of j (like company holidays).
+func (hc *HolidayCalendar) AddHoliday(j Jalaali, name string) *HolidayCalendar {
+	year, month, day := j.Date()
+	hc.holidays = append(hc.holidays, Holiday{Name: name, Year: year, Month: month, Day: day})
+	return hc
+}
+
+// AddProvider add a holiday provider like lunar holidays.
+func (hc *HolidayCalendar) AddProvider(p HolidayProvider) *HolidayCalendar {
+	hc.providers = append(hc.providers, p)
+	return hc
+}
+
+// Holidays returns the named holidays on the date of j.
+func (hc *HolidayCalendar) Holidays(j Jalaali) []Holiday {
+	return hc.holidaysOf(j, make(map[int][]Holiday))
+}
+
+// IsWeekend returns true if the weekday of j is weekend.
+func (hc *HolidayCalendar) IsWeekend(j Jalaali) bool {
+	return slices.Contains(hc.weekends, j.Weekday())
+}
+
+// IsHoliday returns true if the date of j is weekend or a named holiday.
+func (hc *HolidayCalendar) IsHoliday(j Jalaali) bool {
+	return hc.isHoliday(j, make(map[int][]Holiday))
+}
+
+// IsWorkday returns true if the date of j is not weekend or holiday.
+func (hc *HolidayCalendar) IsWorkday(j Jalaali) bool {
+	return !hc.IsHoliday(j)
+}
+
+// AddWorkdays add n workdays to j and returns a new instance.
+// Negative n moves backward. Time of j is kept.
+// If n is zero, j is returned even if it is not a workday.
+// It returns ErrNoWorkdays if no workday found in a whole year.
+func (hc *HolidayCalendar) AddWorkdays(j Jalaali, n int) (Jalaali, error) {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	if n > 0 && hc.weekendSet() == [7]bool{true, true, true, true, true, true, true} {
+		return nil, ErrNoWorkdays
+	}
+
+	run := 0
+	cache := make(map[int][]Holiday)
+	for n > 0 {
+		j = j.AddDate(0, 0, step)
+		if hc.isHoliday(j, cache) {
+			if run++; run > maxHolidayRun {
+				return nil, ErrNoWorkdays
+			}
+			continue
+		}
+		run = 0
+		n--
+	}
+	return j, nil
+}
+
+// WorkdaysBetween returns the number of workdays from the date of a (inclusive)
+// to the date of b (exclusive). It returns a negative number if b is before a.
+// Holiday dates are resolved using the calendar rule of a.
+func (hc *HolidayCalendar) WorkdaysBetween(a, b Jalaali) int {
+	rule := ruleOf(a)
+	from := jdnOf(a)
+	to := jdnOf(New(b.Time().In(a.Location())))
+	if to < from {
+		return -hc.workdays(to, from, rule)
+	}
+	return hc.workdays(from, to, rule)
+}
+
+// workdays returns the number of workdays in julian day range [from, to).
+// Weekends of whole weeks are counted arithmetically and holidays per year of rule.
+func (hc *HolidayCalendar) workdays(from, to int, rule CalendarRule) int {
+	if from >= to {
+		return 0
+	}
+
+	weekend := hc.weekendSet()
+	weekdays := 0
+	for _, w := range weekend {
+		if !w {
+			weekdays++
+		}
+	}
+
+	weeks := (to - from) / 7
+	count := weeks * weekdays
+	for jdn := from + weeks*7; jdn < to; jdn++ {
+		if !weekend[jdnWeekday(jdn)] {
+			count++
+		}
+	}
+
+	first, _, _ := rule.FromJDN(from)
+	last, _, _ := rule.FromJDN(to - 1)
+	for year := first; year <= last; year++ {
+		seen := make(map[int]bool)
+		for _, h := range hc.yearHolidays(year) {
+			if !IsValidDateWithRule(year, h.Month, h.Day, rule) {
+				continue
+			}
+			jdn := rule.ToJDN(year, int(h.Month), h.Day)
+			if jdn < from || jdn >= to || seen[jdn] || weekend[jdnWeekday(jdn)] {
+				continue
+			}
+			seen[jdn] = true
+			count--
+		}
+	}
+	return count
+}
+
+// weekendSet returns weekends of calendar indexed by weekday.
+func (hc *HolidayCalendar) weekendSet() [7]bool {
+	var res [7]bool
+	for _, w := range hc.weekends {
+		if w >= Shanbeh && w <= Jomeh {
+			res[w] = true
+		}
+	}
+	return res
+}
+
+func (hc *HolidayCalendar) isHoliday(j Jalaali, cache map[int][]Holiday) bool {
+	return hc.IsWeekend(j) || len(hc.holidaysOf(j, cache)) > 0
+}
+
+// holidaysOf returns holidays on the date of j
+// and caches holidays of year.
+func (hc *HolidayCalendar) holidaysOf(j Jalaali, cache map[int][]Holiday) []Holiday {
+	year, month, day := j.Date()
+	holidays, ok := cache[year]
+	if !ok {
+		holidays = hc.yearHolidays(year)
+		cache[year] = holidays
+	}
+
+	var res []Holiday
+	for _, h := range holidays {
+		if h.Month == month && h.Day == day {
+			res = append(res, h)
+		}
+	}
+	return res
+}
+
+// yearHolidays returns all holidays of year with year set.
+func (hc *HolidayCalendar) yearHolidays(year int) []Holiday {
+	var res []Holiday
+	for _, h := range hc.holidays {
+		if h.Year == 0 || h.Year == year {
+			h.Year = year
+			res = append(res, h)
+		}
+	}
+	for _, p := range hc.providers {
+		for _, h := range p.Holidays(year) {
+			if h.Year == 0 || h.Year == year {
+				h.Year = year
+				res = append(res, h)
+			}
+		}
+	}
+	return res
+}
+
+// HijriHoliday represents a holiday recurring every year in hijri calendar.
+type HijriHoliday struct {
+	Name  string
+	Month HijriMonth
+	Day   int // Clamped to the last day of month if exceeded
+}
+
+// iranLunarHolidays is the list of official iranian lunar holidays.
+var iranLunarHolidays = []HijriHoliday{
+	{Name: "تاسوعای حسینی", Month: Muharram, Day: 9},
+	{Name: "عاشورای حسینی", Month: Muharram, Day: 10},
+	{Name: "اربعین حسینی", Month: Safar, Day: 20},
+	{Name: "رحلت رسول اکرم و شهادت امام حسن مجتبی", Month: Safar, Day: 28},
+	{Name: "شهادت امام رضا", Month: Safar, Day: 30},
+	{Name: "شهادت امام حسن عسکری", Month: RabiAlAwwal, Day: 8},
+	{Name: "میلاد رسول اکرم و امام جعفر صادق", Month: RabiAlAwwal, Day: 17},
+	{Name: "شهادت حضرت فاطمه زهرا", Month: JumadaAlAkhirah, Day: 3},
+	{Name: "ولادت امام علی", Month: Rajab, Day: 13},
+	{Name: "مبعث رسول اکرم", Month: Rajab, Day: 27},
+	{Name: "ولادت حضرت قائم", Month: Shaban, Day: 15},
+	{Name: "شهادت امام علی", Month: Ramadan, Day: 21},
+	{Name: "عید سعید فطر", Month: Shawwal, Day: 1},
+	{Name: "تعطیل به مناسبت عید سعید فطر", Month: Shawwal, Day: 2},
+	{Name: "شهادت امام جعفر صادق", Month: Shawwal, Day: 25},
+	{Name: "عید سعید قربان", Month: DhuAlHijjah, Day: 10},
+	{Name: "عید سعید غدیر خم", Month: DhuAlHijjah, Day: 18},
+}
+
+// lunarHolidays provides hijri holidays in jalaali calendar.
+type lunarHolidays struct {
+	conv     HijriConverter
+	holidays []HijriHoliday
+}
+
+// NewLunarHolidays create a HolidayProvider of hijri holidays converted using conv.
+// TabularHijri is used if conv is nil. Holiday dates are in ArithmeticRule.
+func NewLunarHolidays(conv HijriConverter, holidays ...HijriHoliday) HolidayProvider {
+	return lunarHolidays{conv: conv, holidays: slices.Clone(holidays)}
+}
+
+// NewIranLunarHolidays create a HolidayProvider of official iranian
+// lunar holidays converted using conv (like a HijriTable of official calendar).
+// TabularHijri is used if conv is nil.
+func NewIranLunarHolidays(conv HijriConverter) HolidayProvider {
+	return NewLunarHolidays(conv, iranLunarHolidays...)
+}
+
+func (lh lunarHolidays) Holidays(year int) []Holiday {
+	conv := hijriConverter(lh.conv)
+
+	// A jalaali year overlaps two or three hijri years
+	first := conv.FromJDN(convertShamsiToJDN(year, 1, 1))
+	last := conv.FromJDN(convertShamsiToJDN(year+1, 1, 1) - 1)
+
+	var res []Holiday
+	for hYear := first.Year; hYear <= last.Year; hYear++ {
+		for _, h := range lh.holidays {
+			start := conv.ToJDN(Hijri{Year: hYear, Month: h.Month, Day: 1})
+			end := conv.ToJDN(Hijri{Year: hYear, Month: h.Month + 1, Day: 1})
+			if h.Month == DhuAlHijjah {
+				end = conv.ToJDN(Hijri{Year: hYear + 1, Month: Muharram, Day: 1})
+			}
+
+			jdn := start + min(h.Day, end-start) - 1
+			y, m, d := convertJDNToShamsi(jdn)
+			if y == year {
+				res = append(res, Holiday{Name: h.Name, Year: y, Month: Month(m), Day: d})
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,140 @@
+package gojalaali_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestHolidayCalendar(t *testing.T) {
+	tz := gojalaali.TehranTz()
+	calendar := gojalaali.NewIranHolidayCalendar().
+		AddHoliday(gojalaali.Date(1403, 7, 17, 0, 0, 0, 0, tz), "Company Day").
+		AddProvider(gojalaali.HolidayFunc(func(year int) []gojalaali.Holiday {
+			if year != 1403 {
+				return nil
+			}
+			return []gojalaali.Holiday{{Name: "Lunar", Month: gojalaali.Mehr, Day: 22}}
+		}))
+
+	t.Run("IsHoliday", func(t *testing.T) {
+		tests := []struct {
+			date    gojalaali.Jalaali
+			holiday bool
+		}{
+			{gojalaali.Date(1403, 1, 1, 10, 0, 0, 0, tz), true},
+			{gojalaali.Date(1403, 1, 13, 10, 0, 0, 0, tz), true},
+			{gojalaali.Date(1403, 11, 22, 10, 0, 0, 0, tz), true},
+			{gojalaali.Date(1403, 7, 17, 10, 0, 0, 0, tz), true},
+			{gojalaali.Date(1404, 7, 17, 10, 0, 0, 0, tz), false},
+			{gojalaali.Date(1403, 7, 22, 10, 0, 0, 0, tz), true},
+			{gojalaali.Date(1403, 7, 20, 10, 0, 0, 0, tz), true}, // Jomeh
+			{gojalaali.Date(1403, 7, 16, 10, 0, 0, 0, tz), false},
+		}
+		for _, test := range tests {
+			if calendar.IsHoliday(test.date) != test.holiday {
+				t.Errorf("Expect holiday %v for %s", test.holiday, test.date)
+			}
+			if calendar.IsWorkday(test.date) == test.holiday {
+				t.Errorf("Expect workday %v for %s", !test.holiday, test.date)
+			}
+		}
+
+		holidays := calendar.Holidays(gojalaali.Date(1403, 1, 12, 0, 0, 0, 0, tz))
+		if len(holidays) != 1 || holidays[0].Name != "روز جمهوری اسلامی" || holidays[0].Year != 1403 {
+			t.Errorf("Unexpected holidays %v", holidays)
+		}
+	})
+
+	t.Run("AddWorkdays", func(t *testing.T) {
+		// 1403/07/16 Doshanbeh, 17 company day, 20 Jomeh, 22 lunar
+		date := gojalaali.Date(1403, 7, 16, 9, 0, 0, 0, tz)
+		tests := []struct {
+			days     int
+			expected string
+		}{
+			{0, "1403-07-16T09:00:00+03:30"},
+			{1, "1403-07-18T09:00:00+03:30"},
+			{3, "1403-07-21T09:00:00+03:30"},
+			{4, "1403-07-23T09:00:00+03:30"},
+			{-1, "1403-07-15T09:00:00+03:30"},
+		}
+		for _, test := range tests {
+			result, err := calendar.AddWorkdays(date, test.days)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.String() != test.expected {
+				t.Errorf("Expect %s but get %s", test.expected, result)
+			}
+		}
+	})
+
+	t.Run("NoWorkdays", func(t *testing.T) {
+		date := gojalaali.Date(1403, 7, 16, 9, 0, 0, 0, tz)
+		weekends := gojalaali.NewHolidayCalendar(
+			gojalaali.Shanbeh, gojalaali.Yekshanbeh, gojalaali.Doshanbeh, gojalaali.Seshanbeh,
+			gojalaali.Charshanbeh, gojalaali.Panjshanbeh, gojalaali.Jomeh,
+		)
+		if _, err := weekends.AddWorkdays(date, 1); !errors.Is(err, gojalaali.ErrNoWorkdays) {
+			t.Errorf("Expect %v but get %v", gojalaali.ErrNoWorkdays, err)
+		}
+		if result, err := weekends.AddWorkdays(date, 0); err != nil || result != date {
+			t.Errorf("Expect %s but get %s (%v)", date, result, err)
+		}
+
+		holidays := gojalaali.NewHolidayCalendar().AddProvider(gojalaali.HolidayFunc(func(year int) []gojalaali.Holiday {
+			var res []gojalaali.Holiday
+			for month := gojalaali.Farvardin; month <= gojalaali.Esfand; month++ {
+				for day := 1; day <= gojalaali.DaysIn(year, month); day++ {
+					res = append(res, gojalaali.Holiday{Name: "Closed", Month: month, Day: day})
+				}
+			}
+			return res
+		}))
+		if _, err := holidays.AddWorkdays(date, -1); !errors.Is(err, gojalaali.ErrNoWorkdays) {
+			t.Errorf("Expect %v but get %v", gojalaali.ErrNoWorkdays, err)
+		}
+	})
+
+	t.Run("WorkdaysBetween", func(t *testing.T) {
+		a := gojalaali.Date(1403, 7, 16, 9, 0, 0, 0, tz)
+		b := gojalaali.Date(1403, 7, 23, 18, 0, 0, 0, tz)
+		if result := calendar.WorkdaysBetween(a, b); result != 4 {
+			t.Errorf("Expect 4 but get %d", result)
+		}
+		if result := calendar.WorkdaysBetween(b, a); result != -4 {
+			t.Errorf("Expect -4 but get %d", result)
+		}
+
+		calendar := gojalaali.NewIranHolidayCalendar().SetWeekends(gojalaali.Panjshanbeh, gojalaali.Jomeh)
+		if result := calendar.WorkdaysBetween(a, b); result != 5 {
+			t.Errorf("Expect 5 but get %d", result)
+		}
+
+		// Compare with day by day count across years
+		from := gojalaali.Date(1399, 12, 25, 9, 0, 0, 0, tz)
+		to := gojalaali.Date(1404, 1, 5, 0, 0, 0, 0, tz)
+		expected := 0
+		for day := from; day.Before(to.BeginningOfDay()); day = day.Tomorrow() {
+			if calendar.IsWorkday(day) {
+				expected++
+			}
+		}
+		if result := calendar.WorkdaysBetween(from, to); result != expected {
+			t.Errorf("Expect %d but get %d", expected, result)
+		}
+
+		// Holidays follow the rule of instance (1177 starts one day later in Borkowski)
+		rule := gojalaali.BorkowskiRule{}
+		calendar = gojalaali.NewHolidayCalendar().AddRecurring(gojalaali.Farvardin, 10, "Test")
+		from = gojalaali.DateWithRule(1177, 1, 10, 0, 0, 0, 0, tz, rule)
+		if calendar.IsWorkday(from) {
+			t.Errorf("Expect %s to be holiday", from)
+		}
+		if result := calendar.WorkdaysBetween(from, from.Tomorrow()); result != 0 {
+			t.Errorf("Expect 0 but get %d", result)
+		}
+	})
+}
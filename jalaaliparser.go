@@ -1,7 +1,6 @@
 package gojalaali
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -9,30 +8,101 @@ import (
 	"time"
 )
 
-// Parse parse jalaali datetime from string with layout.www
+// Parser holds options for parsing jalaali datetime strings.
+// The zero value is ready to use and behaves like Parse.
+// A Parser is safe for concurrent use if its fields are not modified.
+type Parser struct {
+	// UnknownZone specifies how zone names of MST layout token
+	// that could not be resolved are handled.
+	UnknownZone UnknownZonePolicy
+
+	// Abbreviations maps extra time zone abbreviations accepted by MST layout token
+	// to their offset in seconds east of UTC. Keys are matched case-insensitively
+	// and take precedence over built-in abbreviations.
+	Abbreviations map[string]int
+
+	// Rule is the calendar rule used to validate and convert parsed date
+	// and is kept by parsed instance. ArithmeticRule is used if nil.
+	Rule CalendarRule
+
+	// LenientWeekday ignores parsed weekday (Monday or Mon layout token)
+	// instead of returning ErrWeekdayMismatch when it does not match the parsed date.
+	LenientWeekday bool
+}
+
+// Parse parse jalaali datetime from string with layout.
 // It returns a Jalaali instance and an error if the parsing fails.
+// Persian (۰-۹) and arabic-indic (٠-٩) digits are accepted for numeric parts.
+// In the absence of a time zone indicator, Parse returns a time in UTC.
 func Parse(layout, datetime string) (Jalaali, error) {
+	return Parser{}.Parse(layout, datetime)
+}
+
+// ParseInLocation is like Parse but differs in two important ways.
+// First, in the absence of time zone information, Parse interprets a time as UTC;
+// ParseInLocation interprets the time as in the given location.
+// Second, when given a zone offset, Parse uses a fixed zone
+// while ParseInLocation uses the given location if the offset matches it.
+// If loc is nil then the local time is used.
+func ParseInLocation(layout, datetime string, loc *time.Location) (Jalaali, error) {
+	return Parser{}.ParseInLocation(layout, datetime, loc)
+}
+
+// ParseRelative is like ParseInLocation but resolves inputs without
+// year, month and day (like weekday only or weekday and time)
+// relative to ref date in location of ref.
+// Weekday resolved to the first day on or after ref with the same weekday
+// and input without weekday resolved to the day of ref.
+func ParseRelative(layout, datetime string, ref Jalaali) (Jalaali, error) {
+	return Parser{}.ParseRelative(layout, datetime, ref)
+}
+
+// Parse is like package Parse using options of p.
+func (p Parser) Parse(layout, datetime string) (Jalaali, error) {
+	return p.parse(layout, datetime, time.UTC, nil)
+}
+
+// ParseInLocation is like package ParseInLocation using options of p.
+func (p Parser) ParseInLocation(layout, datetime string, loc *time.Location) (Jalaali, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	return p.parse(layout, datetime, loc, nil)
+}
+
+// ParseRelative is like package ParseRelative using options of p.
+func (p Parser) ParseRelative(layout, datetime string, ref Jalaali) (Jalaali, error) {
+	if ref == nil || ref.IsZero() {
+		ref = Now()
+	}
+	return p.parse(layout, datetime, ref.Location(), ref)
+}
+
+func (p Parser) parse(layout, datetime string, loc *time.Location, ref Jalaali) (Jalaali, error) {
 	// Skip empty layout
 	if strings.TrimSpace(layout) == "" {
-		return nil, errors.New("layout cannot be empty")
+		return nil, newParseError(layout, datetime, "", "", ErrEmptyLayout)
 	}
 
 	// Skip empty datetime
 	if strings.TrimSpace(datetime) == "" {
-		return nil, errors.New("datetime cannot be empty")
+		return nil, newParseError(layout, datetime, "", "", ErrEmptyValue)
 	}
 
+	// Normalize persian and arabic-indic digits
+	input := normalizeDigits(datetime)
+
 	// Proccess layout
 	expression := getLayoutExpression(layout)
 	rx, err := regexp.Compile(expression)
 	if err != nil {
-		return nil, errors.New("invalid layout")
+		return nil, newParseError(layout, datetime, "", "", ErrInvalidLayout)
 	}
 
 	// Get layour args
-	matches := rx.FindStringSubmatch(datetime)
+	matches := rx.FindStringSubmatch(input)
 	if matches == nil {
-		return nil, errors.New("input does not match layout")
+		return nil, newParseError(layout, datetime, "", "", ErrLayoutMismatch)
 	}
 
 	// Resolve parts
@@ -45,66 +115,73 @@ func Parse(layout, datetime string) (Jalaali, error) {
 
 	// Parse year
 	var year int
-	if v, _ := strconv.Atoi(resultMap["2006"]); v > 0 {
+	if v, ok := parseNumber(resultMap["2006"]); ok {
 		year = v
-	} else if v, _ := strconv.Atoi(resultMap["06"]); v > 0 {
+	} else if v, ok := parseNumber(resultMap["06"]); ok {
 		year = 1400 + v
 	}
 
 	// Parse month
-	var month int
-	if v, _ := strconv.Atoi(resultMap["01"]); v > 0 {
+	month := 1
+	if v, ok := parseNumber(resultMap["01"]); ok {
 		month = v
-	} else if v, _ := strconv.Atoi(resultMap["1"]); v > 0 {
+	} else if v, ok := parseNumber(resultMap["1"]); ok {
 		month = v
 	} else if v := parseMonth(resultMap["January"], resultMap["Jan"]); v > 0 {
 		month = int(v)
-	} else {
-		month = 1
+	}
+
+	// Parse season
+	hasMonth := firstValue(
+		resultMap["01"], resultMap["1"], resultMap["January"], resultMap["Jan"],
+	) != ""
+	season := parseSeason(resultMap["Spring"])
+	if v, ok := parseNumber(resultMap["QQ"]); ok && season == 0 {
+		season = Season(v)
+	}
+	if season > 0 && !hasMonth {
+		month = int(season.FirstMonth())
 	}
 
 	// Parse day
-	var day int
-	if v, _ := strconv.Atoi(resultMap["02"]); v > 0 {
+	day := 1
+	if v, ok := parseNumber(resultMap["02"]); ok {
 		day = v
-	} else if v, _ := strconv.Atoi(strings.TrimSpace(resultMap["_2"])); v > 0 {
+	} else if v, ok := parseNumber(resultMap["_2"]); ok {
 		day = v
-	} else if v, _ := strconv.Atoi(resultMap["2"]); v > 0 {
+	} else if v, ok := parseNumber(resultMap["2"]); ok {
 		day = v
-	} else {
-		day = 1
 	}
 
 	// Parse hour
-	isPm := parseAmPm(resultMap["PM"], resultMap["pm"]) == Pm
+	marker := firstValue(resultMap["PM"], resultMap["pm"])
+	isPm := marker != "" && parseAmPm(marker) == Pm
+	isAm := marker != "" && !isPm
 	var hour int
-	if v, _ := strconv.Atoi(resultMap["15"]); v > 0 {
-		hour = v
-	} else if v, _ := strconv.Atoi(resultMap["03"]); v > 0 {
-		if isPm {
-			v = v + 12
-		}
+	if v, ok := parseNumber(resultMap["15"]); ok {
 		hour = v
-	} else if v, _ := strconv.Atoi(resultMap["3"]); v > 0 {
-		if isPm {
+	} else if v, ok := parseNumber(firstValue(resultMap["03"], resultMap["3"])); ok {
+		if isPm && v < 12 {
 			v = v + 12
+		} else if isAm && v == 12 {
+			v = 0
 		}
 		hour = v
 	}
 
 	// Parse minute
 	var minute int
-	if v, _ := strconv.Atoi(resultMap["04"]); v > 0 {
+	if v, ok := parseNumber(resultMap["04"]); ok {
 		minute = v
-	} else if v, _ := strconv.Atoi(resultMap["4"]); v > 0 {
+	} else if v, ok := parseNumber(resultMap["4"]); ok {
 		minute = v
 	}
 
 	// Parse second
 	var second int
-	if v, _ := strconv.Atoi(resultMap["05"]); v > 0 {
+	if v, ok := parseNumber(resultMap["05"]); ok {
 		second = v
-	} else if v, _ := strconv.Atoi(resultMap["5"]); v > 0 {
+	} else if v, ok := parseNumber(resultMap["5"]); ok {
 		second = v
 	}
 
@@ -120,40 +197,118 @@ func Parse(layout, datetime string) (Jalaali, error) {
 		resultMap["Z07_00_00"], resultMap["Z07_00"], resultMap["Z07"],
 		resultMap["070000"], resultMap["0700"], resultMap["07_00_00"],
 		resultMap["07_00"], resultMap["07"],
+		resultMap["MST"],
 	)
 
+	// Resolve zone name if no offset parsed
+	if name := resultMap["MST"]; timezone == nil && name != "" {
+		timezone = resolveZone(name, p.Abbreviations)
+		if timezone == nil && p.UnknownZone == UnknownZoneError {
+			return nil, newParseError(layout, datetime, "zone", name, ErrUnknownZone)
+		}
+	}
+
+	// Parse weekday
+	weekday, hasWeekday := parseWeekday(resultMap["Monday"], resultMap["Mon"])
+	hasDate := hasMonth || season > 0 || firstValue(
+		resultMap["2006"], resultMap["06"],
+		resultMap["02"], resultMap["_2"], resultMap["2"],
+	) != ""
+
+	// Resolve date relative to reference
+	if !hasDate && ref != nil {
+		diff := 0
+		if hasWeekday {
+			diff = (int(weekday) - int(ref.Weekday()) + 7) % 7
+		}
+		y, m, d := ref.AddDate(0, 0, diff).Date()
+		year, month, day = y, int(m), d
+	}
+
 	// Validate
 	if month < 1 || month > 12 {
-		return nil, errors.New("invalid jalaali date input")
+		return nil, newParseError(
+			layout, datetime, "month",
+			firstValue(resultMap["01"], resultMap["1"]),
+			ErrMonthOutOfRange,
+		)
 	}
 
-	days := 0
-	if isLeap(year) {
-		days = monthMeta[month-1][1]
-	} else {
-		days = monthMeta[month-1][0]
+	dayValue := firstValue(resultMap["02"], resultMap["_2"], resultMap["2"])
+	rule := p.Rule
+	if rule == nil {
+		rule = ArithmeticRule{}
 	}
-	if day < 1 || day > days {
-		return nil, errors.New("invalid jalaali date input")
+	if month == int(Esfand) && day == 30 && !rule.IsLeap(year) {
+		return nil, newParseError(layout, datetime, "day", dayValue, ErrNonLeapYear)
+	}
+
+	if day < 1 || day > daysOfMonth(Month(month), rule.IsLeap(year)) {
+		return nil, newParseError(layout, datetime, "day", dayValue, ErrDayOutOfRange)
 	}
 
 	if hour < 0 || hour > 23 {
-		return nil, errors.New("invalid jalaali date input")
+		return nil, newParseError(
+			layout, datetime, "hour",
+			firstValue(resultMap["15"], resultMap["03"], resultMap["3"]),
+			ErrHourOutOfRange,
+		)
 	}
 
 	if minute < 0 || minute > 59 {
-		return nil, errors.New("invalid jalaali date input")
+		return nil, newParseError(
+			layout, datetime, "minute",
+			firstValue(resultMap["04"], resultMap["4"]),
+			ErrMinuteOutOfRange,
+		)
 	}
 
 	if second < 0 || second > 59 {
-		return nil, errors.New("invalid jalaali date input")
+		return nil, newParseError(
+			layout, datetime, "second",
+			firstValue(resultMap["05"], resultMap["5"]),
+			ErrSecondOutOfRange,
+		)
+	}
+
+	// Validate season
+	if season > 0 && hasMonth && Season((month-1)/3+1) != season {
+		return nil, newParseError(
+			layout, datetime, "season",
+			firstValue(resultMap["Spring"], resultMap["QQ"]),
+			ErrSeasonMismatch,
+		)
+	}
+
+	// Validate weekday
+	if hasWeekday && hasDate && !p.LenientWeekday {
+		expected := DateWithRule(year, Month(month), day, 0, 0, 0, 0, loc, rule).Weekday()
+		if weekday != expected {
+			return nil, newParseError(
+				layout, datetime, "weekday",
+				firstValue(resultMap["Monday"], resultMap["Mon"]),
+				ErrWeekdayMismatch,
+			)
+		}
+	}
+
+	// Create date in location if no offset parsed
+	if timezone == nil {
+		return DateWithRule(
+			year, Month(month), day,
+			hour, minute, second, nsec,
+			loc, rule), nil
 	}
 
-	// Create date
-	return Date(
+	// Create date in parsed offset and prefer location if offset matches
+	res := DateWithRule(
 		year, Month(month), day,
 		hour, minute, second, nsec,
-		timezone), nil
+		timezone, rule)
+	if t := res.Time().In(loc); zoneOffset(t) == zoneOffset(res.Time()) {
+		return NewWithRule(t, rule), nil
+	}
+	return res, nil
 }
 
 // getLayoutExpression get regex pattern for layout
@@ -192,12 +347,15 @@ func getLayoutExpression(layout string) string {
 		".000000000", `(?P<000000000>\.\d{9})?`,
 		".000000", `(?P<000000>\.\d{6})?`,
 		".000", `(?P<000>\.\d{3})?`,
+		// Season
+		"Spring", `(?P<Spring>`+seasonsStr()+`)`,
+		"QQ", `(?P<QQ>[1-4])`,
 		// Daytime
 		"Morning", `(?P<Morning>`+daytimeStr()+`)`,
 		"PM", `(?P<PM>`+amPmStr()+`)`,
 		"pm", `(?P<pm>`+shortAmPmStr()+`)`,
 		// Timezone
-		"MST", `(?P<MST>([A-Za-z\/]+)|([-+]\d{4}))`,
+		"MST", `(?P<MST>([A-Za-z][A-Za-z_\/-]*([-+]\d{1,2})?)|([-+]\d{4}))`,
 		"Z070000", `(?P<Z070000>Z|([+-]\d{6}))`,
 		"Z0700", `(?P<Z0700>Z|([+-]\d{4}))`,
 		"Z07:00:00", `(?P<Z07_00_00>Z|([+-]\d{2}:\d{2}:\d{2}))`,
@@ -211,6 +369,42 @@ func getLayoutExpression(layout string) string {
 	).Replace(layout) + "$"
 }
 
+// normalizeDigits replace persian (U+06F0-U+06F9)
+// and arabic-indic (U+0660-U+0669) digits with ascii digits.
+func normalizeDigits(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '۰' && r <= '۹':
+			return '0' + (r - '۰')
+		case r >= '٠' && r <= '٩':
+			return '0' + (r - '٠')
+		default:
+			return r
+		}
+	}, value)
+}
+
+// parseNumber parse numeric part of input.
+// It returns false if value is empty or not a number.
+func parseNumber(value string) (int, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	v, err := strconv.Atoi(value)
+	return v, err == nil
+}
+
+// firstValue returns the first non-empty value.
+func firstValue(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 func parseNanosec(values ...string) int {
 	for _, value := range values {
 		if value != "" {
@@ -226,9 +420,15 @@ func parseNanosec(values ...string) int {
 	return 0
 }
 
+// parseTimezone returns parsed offset location
+// or nil if no offset passed.
 func parseTimezone(values ...string) *time.Location {
 	rx, _ := regexp.Compile(`^([+-])(\d{2}):?(\d{2})?:?(\d{2})?$`)
 	for _, value := range values {
+		if value == "Z" {
+			return time.UTC
+		}
+
 		matches := rx.FindStringSubmatch(value)
 		if len(matches) != 5 {
 			continue
@@ -265,5 +465,11 @@ func parseTimezone(values ...string) *time.Location {
 		)
 	}
 
-	return time.UTC
+	return nil
+}
+
+// zoneOffset returns the zone offset of t in seconds east of UTC.
+func zoneOffset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
 }
@@ -1,7 +1,9 @@
 package gojalaali_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/mekramy/gojalaali"
 )
@@ -155,3 +157,341 @@ func TestZoneParse(t *testing.T) {
 		}
 	}
 }
+
+func TestDigitsParse(t *testing.T) {
+	tests := []struct {
+		layout   string
+		datetime string
+		expected string
+	}{
+		{"2006/01/02", "۱۴۰۳/۰۷/۱۵", "1403/07/15"},
+		{"2006/01/02", "١٤٠٣/٠٧/١٥", "1403/07/15"},
+		{"2006/1/2 15:04", "۱۴۰3/٧/15 ۰۸:٠٠", "1403/7/15 08:00"},
+		{"2 January 2006", "۱۵ مهر ۱۴۰۳", "15 مهر 1403"},
+		{"15:04:05.999 -07:00", "۰۸:۳۰:۱۵.۱۲ +۰۳:۳۰", "08:30:15.12 +03:30"},
+	}
+
+	for _, test := range tests {
+		jalaali, err := gojalaali.Parse(test.layout, test.datetime)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		formatted := jalaali.Format(test.layout)
+		if formatted != test.expected {
+			t.Errorf("fail %s, expected %s, got %s", test.layout, test.expected, formatted)
+		}
+	}
+}
+
+func TestParseInLocation(t *testing.T) {
+	tests := []struct {
+		layout   string
+		datetime string
+		expected string
+	}{
+		{"2006/01/02 15:04", "1403/07/15 08:00", "1403-07-15T08:00:00+03:30 Asia/Tehran"},
+		{"2006/01/02 15:04 -07:00", "1403/07/15 08:00 +03:30", "1403-07-15T08:00:00+03:30 Asia/Tehran"},
+		{"2006/01/02 15:04 -07:00", "1403/07/15 08:00 +04:30", "1403-07-15T08:00:00+04:30 +04:30"},
+		{"2006/01/02 15:04 Z07:00", "1403/07/15 08:00 Z", "1403-07-15T08:00:00Z UTC"},
+	}
+
+	for _, test := range tests {
+		jalaali, err := gojalaali.ParseInLocation(test.layout, test.datetime, gojalaali.TehranTz())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		formatted := jalaali.String() + " " + jalaali.Location().String()
+		if formatted != test.expected {
+			t.Errorf("fail %s, expected %s, got %s", test.datetime, test.expected, formatted)
+		}
+	}
+
+	jalaali, err := gojalaali.Parse("2006/01/02 15:04", "1403/07/15 08:00")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if jalaali.Location() != time.UTC {
+		t.Errorf("expected UTC location, got %s", jalaali.Location())
+	}
+}
+
+func TestAmPmParse(t *testing.T) {
+	tests := []struct {
+		layout   string
+		datetime string
+		hour     int
+	}{
+		{"03:04 PM", "12:30 قبل از ظهر", 0},
+		{"03:04 PM", "11:30 قبل از ظهر", 11},
+		{"03:04 PM", "12:30 بعد از ظهر", 12},
+		{"3:04 pm", "1:30 ب.ظ", 13},
+		{"3:04 pm", "12:30 ق.ظ", 0},
+		{"03:04", "12:30", 12},
+	}
+
+	for _, test := range tests {
+		jalaali, err := gojalaali.Parse(test.layout, test.datetime)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if jalaali.Hour() != test.hour {
+			t.Errorf("fail %s, expected %d, got %d", test.datetime, test.hour, jalaali.Hour())
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		layout   string
+		datetime string
+		element  string
+		err      error
+	}{
+		{"", "1403", "", gojalaali.ErrEmptyLayout},
+		{"2006", " ", "", gojalaali.ErrEmptyValue},
+		{"2006/01/02", "1403-01-02", "", gojalaali.ErrLayoutMismatch},
+		{"2006/01/02", "1403/00/02", "month", gojalaali.ErrMonthOutOfRange},
+		{"2006/01/02", "1403/13/02", "month", gojalaali.ErrMonthOutOfRange},
+		{"2006/01/02", "1403/07/31", "day", gojalaali.ErrDayOutOfRange},
+		{"2006/01/02", "1403/07/00", "day", gojalaali.ErrDayOutOfRange},
+		{"2006/01/02", "1404/12/30", "day", gojalaali.ErrNonLeapYear},
+		{"2006/01/02", "1404/12/30", "day", gojalaali.ErrDayOutOfRange},
+		{"15:04:05", "24:00:00", "hour", gojalaali.ErrHourOutOfRange},
+		{"15:04:05", "23:60:00", "minute", gojalaali.ErrMinuteOutOfRange},
+		{"15:04:05", "23:59:60", "second", gojalaali.ErrSecondOutOfRange},
+	}
+
+	for _, test := range tests {
+		_, err := gojalaali.Parse(test.layout, test.datetime)
+		if !errors.Is(err, test.err) {
+			t.Errorf("fail %s, expected %v, got %v", test.datetime, test.err, err)
+			continue
+		}
+
+		var parseErr *gojalaali.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("fail %s, expected *ParseError, got %T", test.datetime, err)
+			continue
+		}
+		if parseErr.Element != test.element || parseErr.Value != test.datetime || parseErr.Layout != test.layout {
+			t.Errorf("fail %s, unexpected error detail %#v", test.datetime, parseErr)
+		}
+	}
+
+	if _, err := gojalaali.Parse("2006/01/02", "1403/12/30"); err != nil {
+		t.Errorf("unexpected error for leap year: %v", err)
+	}
+}
+
+func TestZoneNameParse(t *testing.T) {
+	tests := []struct {
+		datetime string
+		expected string
+	}{
+		{"1403/07/15 08:00 Asia/Tehran", "1403-07-15T08:00:00+03:30 Asia/Tehran"},
+		{"1403/07/15 08:00 Asia/Kabul", "1403-07-15T08:00:00+04:30 Asia/Kabul"},
+		{"1403/07/15 08:00 IRST", "1403-07-15T08:00:00+03:30 IRST"},
+		{"1403/07/15 08:00 aft", "1403-07-15T08:00:00+04:30 AFT"},
+		{"1403/07/15 08:00 UTC", "1403-07-15T08:00:00Z UTC"},
+		{"1403/07/15 08:00 +0330", "1403-07-15T08:00:00+03:30 +03:30"},
+	}
+
+	for _, test := range tests {
+		jalaali, err := gojalaali.Parse("2006/01/02 15:04 MST", test.datetime)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		formatted := jalaali.String() + " " + jalaali.Location().String()
+		if formatted != test.expected {
+			t.Errorf("fail %s, expected %s, got %s", test.datetime, test.expected, formatted)
+		}
+	}
+
+	t.Run("DST", func(t *testing.T) {
+		if _, err := time.LoadLocation("Asia/Tehran"); err != nil {
+			t.Skip("time zone database not available")
+		}
+
+		// Iran observed daylight saving time until 1401
+		jalaali, err := gojalaali.Parse("2006/01/02 15:04 MST", "1400/04/01 08:00 Asia/Tehran")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if formatted := jalaali.String(); formatted != "1400-04-01T08:00:00+04:30" {
+			t.Errorf("expected %s, got %s", "1400-04-01T08:00:00+04:30", formatted)
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := gojalaali.Parse("2006/01/02 MST", "1403/07/15 XYZT")
+		if !errors.Is(err, gojalaali.ErrUnknownZone) {
+			t.Errorf("expected %v, got %v", gojalaali.ErrUnknownZone, err)
+		}
+
+		parser := gojalaali.Parser{UnknownZone: gojalaali.UnknownZoneIgnore}
+		jalaali, err := parser.ParseInLocation("2006/01/02 MST", "1403/07/15 XYZT", gojalaali.KabulTz())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if jalaali.Location().String() != gojalaali.KabulTz().String() {
+			t.Errorf("expected %s, got %s", gojalaali.KabulTz(), jalaali.Location())
+		}
+	})
+
+	t.Run("Abbreviations", func(t *testing.T) {
+		parser := gojalaali.Parser{Abbreviations: map[string]int{"Pkt": 18000, "irst": 16200}}
+		tests := []struct {
+			datetime string
+			expected string
+		}{
+			{"1403/07/15 08:00 PKT", "1403-07-15T08:00:00+05:00 PKT"},
+			{"1403/07/15 08:00 IRST", "1403-07-15T08:00:00+04:30 IRST"},
+		}
+		for _, test := range tests {
+			jalaali, err := parser.Parse("2006/01/02 15:04 MST", test.datetime)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			formatted := jalaali.String() + " " + jalaali.Location().String()
+			if formatted != test.expected {
+				t.Errorf("fail %s, expected %s, got %s", test.datetime, test.expected, formatted)
+			}
+		}
+
+		if _, err := gojalaali.Parse("2006/01/02 MST", "1403/07/15 PKT"); !errors.Is(err, gojalaali.ErrUnknownZone) {
+			t.Errorf("expected %v, got %v", gojalaali.ErrUnknownZone, err)
+		}
+	})
+}
+
+func TestWeekdayParse(t *testing.T) {
+	t.Run("Strict", func(t *testing.T) {
+		if _, err := gojalaali.Parse("Monday 2006/01/02", "یک‌شنبه ۱۴۰۳/۰۷/۱۵"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		_, err := gojalaali.Parse("Monday 2006/01/02", "جمعه ۱۴۰۳/۰۷/۱۵")
+		if !errors.Is(err, gojalaali.ErrWeekdayMismatch) {
+			t.Errorf("expected %v, got %v", gojalaali.ErrWeekdayMismatch, err)
+		}
+
+		_, err = gojalaali.Parse("Mon 2006/01/02", "ج 1403/07/15")
+		if !errors.Is(err, gojalaali.ErrWeekdayMismatch) {
+			t.Errorf("expected %v, got %v", gojalaali.ErrWeekdayMismatch, err)
+		}
+	})
+
+	t.Run("Lenient", func(t *testing.T) {
+		parser := gojalaali.Parser{LenientWeekday: true}
+		jalaali, err := parser.Parse("Monday 2006/01/02", "جمعه ۱۴۰۳/۰۷/۱۵")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if jalaali.Weekday() != gojalaali.Yekshanbeh {
+			t.Errorf("expected %s, got %s", gojalaali.Yekshanbeh, jalaali.Weekday())
+		}
+	})
+
+	t.Run("Relative", func(t *testing.T) {
+		ref := gojalaali.Date(1403, 07, 15, 12, 0, 0, 0, gojalaali.TehranTz())
+		tests := []struct {
+			layout   string
+			datetime string
+			expected string
+		}{
+			{"Monday 15:04", "جمعه 10:00", "1403-07-20T10:00:00+03:30"},
+			{"Monday", "یک‌شنبه", "1403-07-15T00:00:00+03:30"},
+			{"Mon", "ش", "1403-07-21T00:00:00+03:30"},
+			{"15:04", "08:30", "1403-07-15T08:30:00+03:30"},
+			{"2006/01/02 Monday", "1403/08/01 سه‌شنبه", "1403-08-01T00:00:00+03:30"},
+		}
+
+		for _, test := range tests {
+			jalaali, err := gojalaali.ParseRelative(test.layout, test.datetime, ref)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if jalaali.String() != test.expected {
+				t.Errorf("fail %s, expected %s, got %s", test.datetime, test.expected, jalaali)
+			}
+		}
+	})
+}
+
+func TestMonthNameParse(t *testing.T) {
+	tests := []struct {
+		layout   string
+		datetime string
+		expected gojalaali.Month
+	}{
+		{"2 January 2006", "۱۵ حمل ۱۴۰۳", gojalaali.Hamal},
+		{"2 January 2006", "1 سنبله 1403", gojalaali.Sonboleh},
+		{"2 January 2006", "1 حوت 1403", gojalaali.Hut},
+		{"2 Jan 2006", "1 عقر 1403", gojalaali.Aqrab},
+		{"2 January 2006", "1 Farvardin 1403", gojalaali.Farvardin},
+		{"2 January 2006", "1 esfand 1403", gojalaali.Esfand},
+		{"2 January 2006", "1 Amordad 1403", gojalaali.Mordad},
+		{"2 January 2006", "1 HAMAL 1403", gojalaali.Hamal},
+		{"2 January 2006", "1 Jauza 1403", gojalaali.Jauza},
+		{"2 Jan 2006", "1 Meh 1403", gojalaali.Mehr},
+		{"2 Jan 2006", "1 miz 1403", gojalaali.Mizan},
+	}
+
+	for _, test := range tests {
+		jalaali, err := gojalaali.Parse(test.layout, test.datetime)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if jalaali.Month() != test.expected {
+			t.Errorf("fail %s, expected %d, got %d", test.datetime, test.expected, jalaali.Month())
+		}
+	}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		date := gojalaali.Date(1403, gojalaali.Qos, 15, 0, 0, 0, 0, gojalaali.KabulTz())
+		formatted := date.FormatFa("2 January 2006")
+		if formatted != "۱۵ قوس ۱۴۰۳" {
+			t.Errorf("expected %s, got %s", "۱۵ قوس ۱۴۰۳", formatted)
+		}
+
+		jalaali, err := gojalaali.ParseInLocation("2 January 2006", formatted, gojalaali.KabulTz())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result := jalaali.FormatFa("2 January 2006"); result != formatted {
+			t.Errorf("expected %s, got %s", formatted, result)
+		}
+	})
+}
+
+func TestSeasonParse(t *testing.T) {
+	tests := []struct {
+		layout   string
+		datetime string
+		expected string
+	}{
+		{"Spring 2006", "پاییز 1403", "1403-07-01"},
+		{"Spring 2006", "خزان 1403", "1403-07-01"},
+		{"2006 QQ", "1403 4", "1403-10-01"},
+		{"Quarter 2006", "Quarter 1403", "1403-01-01"}, // Literal Q
+		{"Spring 2006/01/02", "تابستان 1403/05/10", "1403-05-10"},
+	}
+
+	for _, test := range tests {
+		jalaali, err := gojalaali.Parse(test.layout, test.datetime)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if formatted := jalaali.Format("2006-01-02"); formatted != test.expected {
+			t.Errorf("fail %s, expected %s, got %s", test.datetime, test.expected, formatted)
+		}
+	}
+
+	_, err := gojalaali.Parse("Spring 2006/01/02", "بهار 1403/05/10")
+	if !errors.Is(err, gojalaali.ErrSeasonMismatch) {
+		t.Errorf("expected %v, got %v", gojalaali.ErrSeasonMismatch, err)
+	}
+}
@@ -0,0 +1,108 @@
+package gojalaali
+
+import "time"
+
+// Period represents a calendar-aware difference between two jalaali instances
+// in jalaali years, months and days plus clock components.
+// All components of a negative period are negative or zero.
+type Period struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// IsZero returns true if all components of period are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Age returns the calendar-aware age of birth at the given moment.
+// It is shorthand for at.Diff(birth).
+func Age(birth, at Jalaali) Period {
+	return at.Diff(birth)
+}
+
+func (jt jTime) Diff(other Jalaali) Period {
+	return Time{jt}.Diff(TimeOf(other))
+}
+
+// diff returns the period from a to jt. a must not be after jt.
+//
+// Months are counted by adding whole months to a with the day clamped to the
+// last day of the target month (e.g. 31 Shahrivar + 1 month is 30 Mehr and
+// 30 Esfand + 1 year is 29 Esfand in non-leap years).
+// Remaining whole days and clock are counted from that anchor.
+func (jt jTime) diff(a *jTime) Period {
+	months := (jt.year-a.year)*12 + int(jt.month-a.month)
+	anchor := a.addMonthsClamped(months)
+	if jt.wallCompare(&anchor) < 0 {
+		months--
+		anchor = a.addMonthsClamped(months)
+	}
+
+	days := jt.jdn() - anchor.jdn()
+	clock := jt.clockNanos() - anchor.clockNanos()
+	if clock < 0 {
+		clock += 24 * time.Hour
+		days--
+	}
+
+	return Period{
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        days,
+		Hours:       int(clock / time.Hour),
+		Minutes:     int(clock % time.Hour / time.Minute),
+		Seconds:     int(clock % time.Minute / time.Second),
+		Nanoseconds: int(clock % time.Second),
+	}
+}
+
+// addMonthsClamped add months to instance and clamp
+// the day to the last day of the target month.
+func (jt jTime) addMonthsClamped(months int) jTime {
+	res := jt
+	total := jt.year*12 + int(jt.month-1) + months
+	res.year = floorDiv(total, 12)
+	res.month = Month(total-res.year*12) + 1
+	res.day = min(jt.day, jt.monthDays(res.year, res.month))
+	return res
+}
+
+// wallCompare compares date and clock of instances regardless of location.
+func (jt jTime) wallCompare(u *jTime) int {
+	a, b := jt.jdn(), u.jdn()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case jt.clockNanos() < u.clockNanos():
+		return -1
+	case jt.clockNanos() > u.clockNanos():
+		return 1
+	default:
+		return 0
+	}
+}
+
+// clockNanos returns the clock of instance as duration since midnight.
+func (jt jTime) clockNanos() time.Duration {
+	return time.Duration(jt.hour)*time.Hour +
+		time.Duration(jt.min)*time.Minute +
+		time.Duration(jt.sec)*time.Second +
+		time.Duration(jt.nsec)
+}
+
+// floorDiv returns a/b rounded toward negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
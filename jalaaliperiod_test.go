@@ -0,0 +1,78 @@
+package gojalaali_test
+
+import (
+	"testing"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestDiff(t *testing.T) {
+	tz := gojalaali.TehranTz()
+	tests := []struct {
+		from     gojalaali.Jalaali
+		to       gojalaali.Jalaali
+		expected gojalaali.Period
+	}{
+		{
+			gojalaali.Date(1400, 1, 10, 8, 0, 0, 0, tz),
+			gojalaali.Date(1402, 4, 15, 10, 30, 0, 0, tz),
+			gojalaali.Period{Years: 2, Months: 3, Days: 5, Hours: 2, Minutes: 30},
+		},
+		{
+			gojalaali.Date(1403, 6, 31, 0, 0, 0, 0, tz),
+			gojalaali.Date(1403, 7, 30, 0, 0, 0, 0, tz),
+			gojalaali.Period{Months: 1},
+		},
+		{
+			gojalaali.Date(1403, 6, 31, 0, 0, 0, 0, tz),
+			gojalaali.Date(1403, 8, 1, 0, 0, 0, 0, tz),
+			gojalaali.Period{Months: 1, Days: 1},
+		},
+		{
+			gojalaali.Date(1403, 12, 30, 0, 0, 0, 0, tz),
+			gojalaali.Date(1404, 12, 29, 0, 0, 0, 0, tz),
+			gojalaali.Period{Years: 1},
+		},
+		{
+			gojalaali.Date(1403, 12, 30, 0, 0, 0, 0, tz),
+			gojalaali.Date(1404, 12, 28, 0, 0, 0, 0, tz),
+			gojalaali.Period{Months: 11, Days: 28},
+		},
+		{
+			gojalaali.Date(1403, 1, 1, 23, 0, 0, 0, tz),
+			gojalaali.Date(1403, 1, 2, 1, 0, 0, 5, tz),
+			gojalaali.Period{Hours: 2, Nanoseconds: 5},
+		},
+		{
+			gojalaali.Date(1403, 5, 10, 0, 0, 0, 0, tz),
+			gojalaali.Date(1403, 5, 10, 0, 0, 0, 0, gojalaali.KabulTz()),
+			gojalaali.Period{Hours: -1},
+		},
+	}
+
+	for _, test := range tests {
+		if result := test.to.Diff(test.from); result != test.expected {
+			t.Errorf("fail %s - %s, expected %+v, got %+v", test.to, test.from, test.expected, result)
+		}
+	}
+
+	t.Run("Negative", func(t *testing.T) {
+		expected := gojalaali.Period{Years: -2, Months: -3, Days: -5, Hours: -2, Minutes: -30}
+		result := tests[0].from.Diff(tests[0].to)
+		if result != expected {
+			t.Errorf("expected %+v, got %+v", expected, result)
+		}
+	})
+
+	t.Run("Age", func(t *testing.T) {
+		birth := gojalaali.Date(1370, 12, 30, 0, 0, 0, 0, tz)
+		result := gojalaali.Age(birth, gojalaali.Date(1403, 12, 29, 12, 0, 0, 0, tz))
+		if result.Years != 32 || result.Months != 11 || result.Days != 29 {
+			t.Errorf("unexpected age %+v", result)
+		}
+		result = gojalaali.Age(birth, gojalaali.Date(1404, 12, 29, 0, 0, 0, 0, tz))
+		if result.Years != 34 || result.Months != 0 || result.Days != 0 {
+			t.Errorf("unexpected age %+v", result)
+		}
+	})
+}
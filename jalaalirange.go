@@ -0,0 +1,167 @@
+package gojalaali
+
+import (
+	"iter"
+	"time"
+)
+
+// Range represents a range of jalaali instants from Start to End.
+// End is excluded from range unless Inclusive is true.
+type Range struct {
+	Start     Jalaali
+	End       Jalaali
+	Inclusive bool
+}
+
+// NewRange create a new range from start (inclusive) to end (exclusive).
+func NewRange(start, end Jalaali) Range {
+	return Range{Start: start, End: end}
+}
+
+// IsEmpty returns true if range contains no instant.
+func (r Range) IsEmpty() bool {
+	if r.Start == nil || r.End == nil {
+		return true
+	}
+	c := r.Start.Compare(r.End)
+	return c > 0 || (c == 0 && !r.Inclusive)
+}
+
+// Contains returns true if j is in range.
+func (r Range) Contains(j Jalaali) bool {
+	if r.IsEmpty() || j == nil {
+		return false
+	}
+	return r.contains(TimeOf(j))
+}
+
+// Overlaps returns true if r and other have at least one instant in common.
+func (r Range) Overlaps(other Range) bool {
+	_, ok := r.Intersect(other)
+	return ok
+}
+
+// Intersect returns the common part of r and other.
+// ok is false if ranges do not overlap.
+func (r Range) Intersect(other Range) (res Range, ok bool) {
+	if r.IsEmpty() || other.IsEmpty() {
+		return Range{}, false
+	}
+
+	res.Start = Max(r.Start, other.Start)
+	switch c := r.End.Compare(other.End); {
+	case c < 0:
+		res.End, res.Inclusive = r.End, r.Inclusive
+	case c > 0:
+		res.End, res.Inclusive = other.End, other.Inclusive
+	default:
+		res.End, res.Inclusive = r.End, r.Inclusive && other.Inclusive
+	}
+
+	if res.IsEmpty() {
+		return Range{}, false
+	}
+	return res, true
+}
+
+// Split splits range into consecutive ranges at the beginning of each calendar unit
+// (e.g. each jalaali month for UnitMonth). All parts exclude their end
+// except the last one which keeps Inclusive of range.
+func (r Range) Split(unit Unit) []Range {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	var res []Range
+	start, end := TimeOf(r.Start), TimeOf(r.End)
+	for {
+		next := addUnit(start.TruncateTo(unit), unit, 1)
+		if next.Compare(start) <= 0 || !next.Before(end) {
+			return append(res, Range{Start: start.Jalaali(), End: r.End, Inclusive: r.Inclusive})
+		}
+		res = append(res, Range{Start: start.Jalaali(), End: next.Jalaali()})
+		start = next
+	}
+}
+
+// Days returns an iterator over range with one day step from Start.
+func (r Range) Days() iter.Seq[Jalaali] {
+	return r.steps(func(start Time, i int) Time {
+		return start.AddDate(0, 0, i)
+	})
+}
+
+// Weeks returns an iterator over range with one week step from Start.
+func (r Range) Weeks() iter.Seq[Jalaali] {
+	return r.steps(func(start Time, i int) Time {
+		return start.AddDate(0, 0, 7*i)
+	})
+}
+
+// Months returns an iterator over range with one month step from Start.
+// Day is clamped to the last day of month (e.g. 31 Shahrivar, 30 Mehr, 30 Aban).
+func (r Range) Months() iter.Seq[Jalaali] {
+	return r.steps(func(start Time, i int) Time {
+		return start.AddDateClamped(0, i, 0)
+	})
+}
+
+// Seasons returns an iterator over range with three months step from Start.
+// Day is clamped to the last day of month.
+func (r Range) Seasons() iter.Seq[Jalaali] {
+	return r.steps(func(start Time, i int) Time {
+		return start.AddDateClamped(0, 3*i, 0)
+	})
+}
+
+// Years returns an iterator over range with one year step from Start.
+// Day is clamped to the last day of month (e.g. 30 Esfand in non-leap years).
+func (r Range) Years() iter.Seq[Jalaali] {
+	return r.steps(func(start Time, i int) Time {
+		return start.AddDateClamped(i, 0, 0)
+	})
+}
+
+// Every returns an iterator over range with step from Start.
+// Years and months of step are added with day clamped to the last day of month
+// and then days and clock are added. Nothing is yielded if step is not positive.
+func (r Range) Every(step Period) iter.Seq[Jalaali] {
+	return r.steps(func(start Time, i int) Time {
+		clock := time.Duration(step.Hours)*time.Hour +
+			time.Duration(step.Minutes)*time.Minute +
+			time.Duration(step.Seconds)*time.Second +
+			time.Duration(step.Nanoseconds)
+		return start.
+			AddDateClamped(i*step.Years, i*step.Months, i*step.Days).
+			Add(time.Duration(i) * clock)
+	})
+}
+
+// steps returns an iterator yielding step(start, i) for i = 0, 1, ...
+// while result is in range.
+func (r Range) steps(step func(start Time, i int) Time) iter.Seq[Jalaali] {
+	return func(yield func(Jalaali) bool) {
+		if r.IsEmpty() {
+			return
+		}
+
+		start := TimeOf(r.Start)
+		if !step(start, 1).After(start) {
+			return
+		}
+		for i := 0; ; i++ {
+			t := step(start, i)
+			if !r.contains(t) || !yield(t.Jalaali()) {
+				return
+			}
+		}
+	}
+}
+
+func (r Range) contains(t Time) bool {
+	if t.Before(TimeOf(r.Start)) {
+		return false
+	}
+	c := t.Compare(TimeOf(r.End))
+	return c < 0 || (c == 0 && r.Inclusive)
+}
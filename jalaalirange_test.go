@@ -0,0 +1,116 @@
+package gojalaali_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestRange(t *testing.T) {
+	tz := gojalaali.TehranTz()
+	date := func(year int, month gojalaali.Month, day int) gojalaali.Jalaali {
+		return gojalaali.Date(year, month, day, 0, 0, 0, 0, tz)
+	}
+	collect := func(items []gojalaali.Jalaali) []string {
+		var res []string
+		for _, item := range items {
+			res = append(res, item.Format("2006-01-02 15:04"))
+		}
+		return res
+	}
+
+	t.Run("Iterators", func(t *testing.T) {
+		r := gojalaali.NewRange(date(1403, 6, 31), date(1403, 10, 1))
+		inclusive := gojalaali.Range{Start: date(1403, 12, 28), End: date(1404, 1, 1), Inclusive: true}
+		leap := gojalaali.Range{Start: date(1403, 12, 30), End: date(1409, 1, 1)}
+
+		tests := []struct {
+			name     string
+			result   []gojalaali.Jalaali
+			expected []string
+		}{
+			{"Days", slices.Collect(inclusive.Days()), []string{"1403-12-28 00:00", "1403-12-29 00:00", "1403-12-30 00:00", "1404-01-01 00:00"}},
+			{"Weeks", slices.Collect(gojalaali.NewRange(date(1403, 7, 1), date(1403, 7, 22)).Weeks()), []string{"1403-07-01 00:00", "1403-07-08 00:00", "1403-07-15 00:00"}},
+			{"Months", slices.Collect(r.Months()), []string{"1403-06-31 00:00", "1403-07-30 00:00", "1403-08-30 00:00", "1403-09-30 00:00"}},
+			{"Seasons", slices.Collect(r.Seasons()), []string{"1403-06-31 00:00", "1403-09-30 00:00"}},
+			{"Years", slices.Collect(leap.Years()), []string{"1403-12-30 00:00", "1404-12-29 00:00", "1405-12-29 00:00", "1406-12-29 00:00", "1407-12-29 00:00", "1408-12-30 00:00"}},
+			{"Every", slices.Collect(inclusive.Every(gojalaali.Period{Days: 1, Hours: 12})), []string{"1403-12-28 00:00", "1403-12-29 12:00", "1404-01-01 00:00"}},
+			{"ZeroStep", slices.Collect(r.Every(gojalaali.Period{})), nil},
+			{"Empty", slices.Collect(gojalaali.NewRange(date(1403, 1, 2), date(1403, 1, 1)).Days()), nil},
+		}
+		for _, test := range tests {
+			if result := collect(test.result); !slices.Equal(result, test.expected) {
+				t.Errorf("%s: expect %v but get %v", test.name, test.expected, result)
+			}
+		}
+
+		// Break iteration
+		for day := range r.Days() {
+			if day.Format("2006-01-02") != "1403-06-31" {
+				t.Errorf("Unexpected day %s", day)
+			}
+			break
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		r := gojalaali.NewRange(date(1403, 1, 1), date(1404, 1, 1))
+		if !r.Contains(date(1403, 1, 1)) || !r.Contains(date(1403, 12, 30)) || r.Contains(date(1404, 1, 1)) {
+			t.Error("Unexpected exclusive contains")
+		}
+
+		r.Inclusive = true
+		if !r.Contains(date(1404, 1, 1)) || r.Contains(date(1402, 12, 29)) || r.Contains(nil) {
+			t.Error("Unexpected inclusive contains")
+		}
+	})
+
+	t.Run("Intersect", func(t *testing.T) {
+		a := gojalaali.NewRange(date(1403, 1, 1), date(1403, 7, 1))
+		b := gojalaali.Range{Start: date(1403, 6, 1), End: date(1403, 12, 1), Inclusive: true}
+		c := gojalaali.NewRange(date(1403, 7, 1), date(1403, 8, 1))
+
+		res, ok := a.Intersect(b)
+		if !ok || res.Start.Format("2006-01-02") != "1403-06-01" || res.End.Format("2006-01-02") != "1403-07-01" || res.Inclusive {
+			t.Errorf("Unexpected intersect %v %v", res, ok)
+		}
+		if !a.Overlaps(b) || !b.Overlaps(c) || a.Overlaps(c) {
+			t.Error("Unexpected overlaps")
+		}
+
+		// Touching end is overlapped only if inclusive
+		a.Inclusive = true
+		if res, ok := a.Intersect(c); !ok || !res.Start.Equal(res.End) {
+			t.Errorf("Expect single instant intersect, got %v %v", res, ok)
+		}
+	})
+
+	t.Run("Split", func(t *testing.T) {
+		r := gojalaali.Range{
+			Start:     gojalaali.Date(1403, 6, 15, 10, 0, 0, 0, tz),
+			End:       date(1403, 8, 10),
+			Inclusive: true,
+		}
+
+		var result []string
+		for _, part := range r.Split(gojalaali.UnitMonth) {
+			result = append(result, part.Start.Format("2006-01-02 15:04")+" "+part.End.Format("2006-01-02 15:04"))
+			if part.Inclusive != part.End.Equal(r.End) {
+				t.Errorf("Unexpected inclusive for %v", part)
+			}
+		}
+		expected := []string{
+			"1403-06-15 10:00 1403-07-01 00:00",
+			"1403-07-01 00:00 1403-08-01 00:00",
+			"1403-08-01 00:00 1403-08-10 00:00",
+		}
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expect %v but get %v", expected, result)
+		}
+
+		if parts := r.Split(gojalaali.UnitYear); len(parts) != 1 {
+			t.Errorf("Expect single part, got %d", len(parts))
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package gojalaali
+
+import "time"
+
+func (jt jTime) Truncate(d time.Duration) Jalaali {
+	return Time{jt}.Truncate(d).Jalaali()
+}
+
+func (jt jTime) Round(d time.Duration) Jalaali {
+	return Time{jt}.Round(d).Jalaali()
+}
+
+func (jt jTime) TruncateTo(unit Unit) Jalaali {
+	return Time{jt}.TruncateTo(unit).Jalaali()
+}
+
+func (jt jTime) CeilTo(unit Unit) Jalaali {
+	return Time{jt}.CeilTo(unit).Jalaali()
+}
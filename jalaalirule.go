@@ -0,0 +1,152 @@
+package gojalaali
+
+import "time"
+
+// CalendarRule defines leap years of jalaali calendar and conversion
+// between jalaali date and julian day number.
+type CalendarRule interface {
+	IsLeap(year int) bool
+	ToJDN(year, month, day int) int
+	FromJDN(jdn int) (year, month, day int)
+}
+
+// NewWithRule create a new jalaali instance from time using rule.
+// The rule is kept by instance and used by its conversion, leap year
+// detection and calendar arithmetic. ArithmeticRule is used if rule is nil.
+// Rule must be comparable like the rules of this package.
+func NewWithRule(t time.Time, rule CalendarRule) Jalaali {
+	var res jTime
+	if t.Year() >= 1097 {
+		res.rule = normalizeRule(rule)
+		res.setTime(t)
+	}
+	return &res
+}
+
+// DateWithRule is like Date but interprets the jalaali date using rule.
+// ArithmeticRule is used if rule is nil.
+func DateWithRule(year int, month Month, day, hour, min, sec, nsec int, loc *time.Location, rule CalendarRule) Jalaali {
+	res := jTime{rule: normalizeRule(rule)}
+	res.set(year, month, day, hour, min, sec, nsec, loc)
+	return &res
+}
+
+// normalizeRule returns nil for ArithmeticRule so instances
+// of default rule are comparable with ==.
+func normalizeRule(rule CalendarRule) CalendarRule {
+	if _, ok := rule.(ArithmeticRule); ok {
+		return nil
+	}
+	return rule
+}
+
+// ArithmeticRule is the 33-year cycle arithmetic rule.
+type ArithmeticRule struct{}
+
+func (ArithmeticRule) IsLeap(year int) bool {
+	return arithmeticIsLeap(year)
+}
+
+func (ArithmeticRule) ToJDN(year, month, day int) int {
+	return arithmeticShamsiToJDN(year, month, day)
+}
+
+func (ArithmeticRule) FromJDN(jdn int) (int, int, int) {
+	return arithmeticJDNToShamsi(jdn)
+}
+
+// BorkowskiRule is the break-year algorithm of Kazimierz M. Borkowski
+// (used by jalaali-js) which matches the official calendar for years
+// in range [-61, 3177]. Years out of range fallback to ArithmeticRule.
+type BorkowskiRule struct{}
+
+// borkowskiBreaks is the list of jalaali years starting a new leap cycle.
+var borkowskiBreaks = []int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+func (r BorkowskiRule) IsLeap(year int) bool {
+	leap, _, _, ok := borkowskiCal(year)
+	if !ok {
+		return arithmeticIsLeap(year)
+	}
+	return leap == 0
+}
+
+func (r BorkowskiRule) ToJDN(year, month, day int) int {
+	_, gy, march, ok := borkowskiCal(year)
+	if !ok {
+		return arithmeticShamsiToJDN(year, month, day)
+	}
+	return convertGregorianPostReformToJDN(gy, 3, march) +
+		(month-1)*31 - month/7*(month-7) + day - 1
+}
+
+func (r BorkowskiRule) FromJDN(jdn int) (int, int, int) {
+	gy, _, _ := convertJDNToGregorianPostReform(jdn)
+	year := gy - 621
+	leap, _, march, ok := borkowskiCal(year)
+	if !ok {
+		return arithmeticJDNToShamsi(jdn)
+	}
+
+	// Days passed from 1 Farvardin
+	k := jdn - convertGregorianPostReformToJDN(gy, 3, march)
+	if k >= 0 {
+		if k <= 185 {
+			return year, 1 + k/31, k%31 + 1
+		}
+		k -= 186
+	} else {
+		// Last months of previous year
+		year--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	}
+	return year, 7 + k/30, k%30 + 1
+}
+
+// borkowskiCal returns the number of years since last leap year (0 means leap),
+// gregorian year of the beginning of jalaali year and the day in march of
+// 1 Farvardin. ok is false if year is out of supported range.
+func borkowskiCal(year int) (leap, gy, march int, ok bool) {
+	breaks := borkowskiBreaks
+	if year < breaks[0] || year >= breaks[len(breaks)-1] {
+		return 0, 0, 0, false
+	}
+
+	// Count leap years until the last break before year
+	gy = year + 621
+	leapJ, jp, jump := -14, breaks[0], 0
+	for _, jm := range breaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+
+	// Day in march of 1 Farvardin
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	// Years since last leap year
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, gy, march, true
+}
@@ -0,0 +1,120 @@
+package gojalaali_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestCalendarRule(t *testing.T) {
+	tests := []struct {
+		rule    gojalaali.CalendarRule
+		leap    bool   // 1176
+		lastDay int    // 1176 Esfand
+		newYear string // 1798-03-21
+		modern  string // 2024-03-20
+		parsed  error  // 1176-12-30
+	}{
+		{gojalaali.ArithmeticRule{}, false, 29, "1177-01-02", "1403-01-01", gojalaali.ErrNonLeapYear},
+		{gojalaali.BorkowskiRule{}, true, 30, "1177-01-01", "1403-01-01", nil},
+	}
+	for _, test := range tests {
+		date := gojalaali.DateWithRule(1176, gojalaali.Esfand, 1, 0, 0, 0, 0, time.UTC, test.rule)
+		if date.IsLeap() != test.leap {
+			t.Errorf("%T: expect leap %v for 1176", test.rule, test.leap)
+		}
+		if res := date.LastMonthDay().Day(); res != test.lastDay {
+			t.Errorf("%T: expect last day %d, got %d", test.rule, test.lastDay, res)
+		}
+		if date.Add(time.Hour).IsLeap() != test.leap {
+			t.Errorf("%T: expect rule kept by Add", test.rule)
+		}
+		if res := date.AddDate(0, 1, 0).Format("2006-01-02"); res != "1177-01-01" {
+			t.Errorf("%T: expect rule kept by AddDate, got %s", test.rule, res)
+		}
+
+		res := gojalaali.NewWithRule(time.Date(1798, 3, 21, 0, 0, 0, 0, time.UTC), test.rule)
+		if res.Format("2006-01-02") != test.newYear {
+			t.Errorf("%T: expect %s, got %s", test.rule, test.newYear, res.Format("2006-01-02"))
+		}
+		if !res.Time().Equal(time.Date(1798, 3, 21, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("%T: expect round trip, got %s", test.rule, res.Time())
+		}
+
+		res = gojalaali.NewWithRule(time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), test.rule)
+		if res.Format("2006-01-02") != test.modern {
+			t.Errorf("%T: expect %s, got %s", test.rule, test.modern, res.Format("2006-01-02"))
+		}
+
+		parser := gojalaali.Parser{Rule: test.rule}
+		parsed, err := parser.Parse("2006-01-02", "1176-12-30")
+		if !errors.Is(err, test.parsed) {
+			t.Errorf("%T: expect error %v, got %v", test.rule, test.parsed, err)
+		}
+		if err == nil && !parsed.Time().Equal(time.Date(1798, 3, 20, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("%T: expect parsed in rule, got %s", test.rule, parsed.Time())
+		}
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		// Values of different rules are converted independently
+		a := gojalaali.Date(1177, gojalaali.Farvardin, 1, 0, 0, 0, 0, time.UTC)
+		b := gojalaali.DateWithRule(1177, gojalaali.Farvardin, 1, 0, 0, 0, 0, time.UTC, gojalaali.BorkowskiRule{})
+		if !a.Time().Equal(time.Date(1798, 3, 20, 0, 0, 0, 0, time.UTC)) || !b.Time().Equal(time.Date(1798, 3, 21, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expect arithmetic default and independent rules, got %s and %s", a.Time(), b.Time())
+		}
+		c := gojalaali.DateWithRule(1403, 1, 1, 0, 0, 0, 0, time.UTC, gojalaali.ArithmeticRule{})
+		if gojalaali.TimeOf(c) != gojalaali.TimeOf(gojalaali.Date(1403, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Error("Expect ArithmeticRule instance to be equal to default instance")
+		}
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		date := gojalaali.NewWithRule(time.Date(1798, 3, 21, 0, 0, 0, 0, time.UTC), gojalaali.BorkowskiRule{})
+		data, err := date.MarshalText()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		res := gojalaali.New(time.Time{})
+		if err := res.UnmarshalText(data); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !res.Equal(date) {
+			t.Errorf("Expect %s, got %s", date.Time(), res.Time())
+		}
+	})
+}
+
+func TestBorkowskiRule(t *testing.T) {
+	rule := gojalaali.BorkowskiRule{}
+	tests := []struct {
+		year, month, day int
+		gregorian        time.Time
+	}{
+		{1360, 5, 26, time.Date(1981, 8, 17, 0, 0, 0, 0, time.UTC)},
+		{1391, 10, 21, time.Date(2013, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{1393, 5, 13, time.Date(2014, 8, 4, 0, 0, 0, 0, time.UTC)},
+		{1403, 12, 30, time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		jdn := rule.ToJDN(test.year, test.month, test.day)
+		y, m, d := rule.FromJDN(jdn)
+		if y != test.year || m != test.month || d != test.day {
+			t.Errorf("Expect round trip of %d-%d-%d, got %d-%d-%d", test.year, test.month, test.day, y, m, d)
+		}
+
+		// Julian day number at noon is unix days plus 2440588
+		if expected := int(test.gregorian.Unix()/86400) + 2440588; jdn != expected {
+			t.Errorf("Expect jdn %d for %d-%d-%d, got %d", expected, test.year, test.month, test.day, jdn)
+		}
+	}
+
+	for year, leap := range map[int]bool{1393: false, 1395: true, 1403: true, 1404: false} {
+		if rule.IsLeap(year) != leap {
+			t.Errorf("Expect leap %v for %d", leap, year)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package gojalaali
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// minGregorianYear is the smallest year that string values
+// scanned from database treated as gregorian datetime.
+const minGregorianYear = 1700
+
+// sqlLayouts is the list of layouts used to parse
+// string values scanned from database.
+var sqlLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+// NullJalaali represents a Jalaali that may be null.
+// NullJalaali implements the sql.Scanner and driver.Valuer interfaces
+// so it can be used as a scan destination, similar to sql.NullTime.
+type NullJalaali struct {
+	Jalaali Jalaali
+	Valid   bool // Valid is true if Jalaali is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (nj *NullJalaali) Scan(value any) error {
+	if value == nil {
+		nj.Jalaali, nj.Valid = nil, false
+		return nil
+	}
+
+	jt := new(jTime)
+	if err := jt.Scan(value); err != nil {
+		nj.Jalaali, nj.Valid = nil, false
+		return err
+	}
+	nj.Jalaali, nj.Valid = jt, true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (nj NullJalaali) Value() (driver.Value, error) {
+	if !nj.Valid || nj.Jalaali == nil {
+		return nil, nil
+	}
+	return nj.Jalaali.Value()
+}
+
+func (jt *jTime) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*jt = jTime{}
+		return nil
+	case time.Time:
+		if v.IsZero() {
+			*jt = jTime{}
+			return nil
+		}
+		if v.Year() < 1097 {
+			return fmt.Errorf("cannot scan %s into jalaali: year out of range", v.Format(time.RFC3339Nano))
+		}
+		*jt = *New(v).(*jTime)
+		return nil
+	case []byte:
+		return jt.scanString(string(v))
+	case string:
+		return jt.scanString(v)
+	default:
+		return fmt.Errorf("cannot scan %T into jalaali", value)
+	}
+}
+
+func (jt jTime) Value() (driver.Value, error) {
+	if jt.IsZero() {
+		return nil, nil
+	}
+	return jt.Time(), nil
+}
+
+// scanString parse gregorian or jalaali datetime string.
+func (jt *jTime) scanString(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		*jt = jTime{}
+		return nil
+	}
+
+	// Try gregorian
+	for _, layout := range sqlLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil && t.Year() >= minGregorianYear {
+			*jt = *New(t).(*jTime)
+			return nil
+		}
+	}
+
+	// Try jalaali
+	for _, layout := range sqlLayouts {
+		res, err := Parse(layout, value)
+		if err == nil {
+			*jt = *res.(*jTime)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot scan %q into jalaali", value)
+}
@@ -0,0 +1,80 @@
+package gojalaali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestSQL(t *testing.T) {
+	expected := gojalaali.Date(1403, 07, 15, 8, 0, 0, 0, gojalaali.TehranTz())
+
+	t.Run("Scan", func(t *testing.T) {
+		values := []any{
+			expected.Time(),
+			[]byte("2024-10-06 08:00:00+03:30"),
+			"2024-10-06T08:00:00+03:30",
+			"1403-07-15 08:00:00+03:30",
+			[]byte("1403-07-15T08:00:00+03:30"),
+		}
+		for _, value := range values {
+			result := gojalaali.New(time.Time{})
+			if err := result.Scan(value); err != nil {
+				t.Fatalf("unexpected error for %v: %v", value, err)
+			}
+			if !expected.Time().Equal(result.Time()) {
+				t.Errorf("Expect %s but get %s", expected, result)
+			}
+		}
+	})
+
+	t.Run("ScanInvalid", func(t *testing.T) {
+		values := []any{10, "1403-13-01", true, time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)}
+		for _, value := range values {
+			if err := gojalaali.New(time.Time{}).Scan(value); err == nil {
+				t.Errorf("Expect error for %v", value)
+			}
+		}
+	})
+
+	t.Run("ScanZero", func(t *testing.T) {
+		result := gojalaali.New(time.Time{})
+		if err := result.Scan(time.Time{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.IsZero() {
+			t.Errorf("Expect zero but get %s", result)
+		}
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		value, err := expected.Value()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, ok := value.(time.Time); !ok || !v.Equal(expected.Time()) {
+			t.Errorf("Expect %s but get %v", expected.Time(), value)
+		}
+	})
+
+	t.Run("NullJalaali", func(t *testing.T) {
+		var null gojalaali.NullJalaali
+		if err := null.Scan(nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if null.Valid {
+			t.Error("Expect invalid NullJalaali")
+		}
+		if value, _ := null.Value(); value != nil {
+			t.Errorf("Expect nil but get %v", value)
+		}
+
+		if err := null.Scan(expected.Time()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !null.Valid || null.Jalaali.String() != expected.String() {
+			t.Errorf("Expect %s but get %v", expected, null.Jalaali)
+		}
+	})
+}
@@ -0,0 +1,692 @@
+package gojalaali
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// Time is a comparable value type representation of jalaali instant
+// with the same methods as Jalaali. Operations on Time do not allocate.
+//
+// Like time.Time, == compares location too; use Equal to compare instants.
+// The zero value represents the zero instant and is safe to use.
+// Jalaali interface is an adapter of Time for backward compatibility.
+type Time struct {
+	jt jTime
+}
+
+// NewTime create a new Time from time.
+func NewTime(t time.Time) Time {
+	var res Time
+	if t.Year() >= 1097 {
+		res.jt.setTime(t)
+	}
+	return res
+}
+
+// TimeDate create a new Time from jalaali date like Date.
+// If loc is nil then the local time is used.
+func TimeDate(year int, month Month, day, hour, min, sec, nsec int, loc *time.Location) Time {
+	var res Time
+	res.jt.set(year, month, day, hour, min, sec, nsec, loc)
+	return res
+}
+
+// TimeNow create a new Time from current time.
+func TimeNow() Time {
+	return NewTime(time.Now())
+}
+
+// TimeOf returns the Time of j. It returns zero Time if j is nil.
+func TimeOf(j Jalaali) Time {
+	switch v := j.(type) {
+	case nil:
+		return Time{}
+	case *jTime:
+		return Time{*v}
+	default:
+		if j.IsZero() {
+			return Time{}
+		}
+		return NewTime(j.Time())
+	}
+}
+
+// date returns a new Time with rule of t like TimeDate.
+func (t Time) date(year int, month Month, day, hour, min, sec, nsec int, loc *time.Location) Time {
+	res := Time{jTime{rule: t.jt.rule}}
+	res.jt.set(year, month, day, hour, min, sec, nsec, loc)
+	return res
+}
+
+// Jalaali returns the instance as Jalaali interface.
+func (t Time) Jalaali() Jalaali {
+	res := t.jt
+	return &res
+}
+
+// IsZero returns true if t is zero time instance.
+func (t Time) IsZero() bool {
+	return t.jt.IsZero()
+}
+
+// IsLeap returns true if the year of t is a leap year.
+func (t Time) IsLeap() bool {
+	return t.jt.IsLeap()
+}
+
+// Since returns the number of seconds between t and u.
+func (t Time) Since(u Time) time.Duration {
+	d := u.Unix() - t.Unix()
+	if d < 0 {
+		d = -d
+	}
+	return time.Duration(d) * time.Second
+}
+
+// Sub returns the duration t-u like time.Time.Sub.
+func (t Time) Sub(u Time) time.Duration {
+	return t.Time().Sub(u.Time())
+}
+
+// Before reports whether the time instant t is before u.
+func (t Time) Before(u Time) bool {
+	return t.Time().Before(u.Time())
+}
+
+// After reports whether the time instant t is after u.
+func (t Time) After(u Time) bool {
+	return t.Time().After(u.Time())
+}
+
+// Equal reports whether t and u represent the same time instant.
+// Unlike ==, two times can be equal even if they are in different locations.
+func (t Time) Equal(u Time) bool {
+	return t.Time().Equal(u.Time())
+}
+
+// Compare compares the time instant t with u like Jalaali.Compare.
+func (t Time) Compare(u Time) int {
+	return t.Time().Compare(u.Time())
+}
+
+// Diff returns the calendar-aware period t-other like Jalaali.Diff.
+func (t Time) Diff(other Time) Period {
+	// Use same location for both instances
+	a := t.jt.fromTime(other.Time().In(t.Time().Location()))
+	b := t.jt
+
+	// Calculate negative period
+	if b.wallCompare(&a) < 0 {
+		p := a.diff(&b)
+		return Period{
+			Years: -p.Years, Months: -p.Months, Days: -p.Days,
+			Hours: -p.Hours, Minutes: -p.Minutes,
+			Seconds: -p.Seconds, Nanoseconds: -p.Nanoseconds,
+		}
+	}
+	return b.diff(&a)
+}
+
+// AmPm returns the 12-Hour marker of t.
+func (t Time) AmPm() AmPm {
+	return t.jt.AmPm()
+}
+
+// Zone returns the zone name and its offset in seconds east of UTC of t.
+func (t Time) Zone() (string, int) {
+	return t.jt.Zone()
+}
+
+// In returns t with location set to loc.
+// If nil loc passed this method returns t.
+func (t Time) In(loc *time.Location) Time {
+	if loc != nil {
+		t.jt.loc = loc
+	}
+	t.jt.resetWeekday()
+	return t
+}
+
+// Add returns t+d.
+func (t Time) Add(d time.Duration) Time {
+	return Time{t.jt.fromTime(t.Time().Add(d))}
+}
+
+// AddTime returns t with hour, minute, second and nanoseconds added.
+func (t Time) AddTime(hour, min, sec, nsec int) Time {
+	hours := time.Duration(hour) * time.Hour
+	mins := time.Duration(min) * time.Minute
+	secs := time.Duration(sec) * time.Second
+	nanos := time.Duration(nsec) * time.Nanosecond
+	return t.Add(hours + mins + secs + nanos)
+}
+
+// AddDate returns t with year, month and day added.
+func (t Time) AddDate(year, month, day int) Time {
+	jt := t.jt
+	return t.date(
+		jt.year+year, jt.month+Month(month), jt.day+day,
+		jt.hour, jt.min, jt.sec, jt.nsec, jt.loc,
+	)
+}
+
+// AddDateClamped returns t with year, month and day added
+// and day clamped to the last day of the target month like Jalaali.AddDateClamped.
+func (t Time) AddDateClamped(year, month, day int) Time {
+	jt := t.jt
+	res := jt.addMonthsClamped(year*12 + month)
+	return t.date(
+		res.year, res.month, res.day+day,
+		jt.hour, jt.min, jt.sec, jt.nsec, jt.loc,
+	)
+}
+
+// AddDatetime returns t with year, month, day,
+// hour, minute, second and nanosecond added.
+func (t Time) AddDatetime(year, month, day, hour, min, sec, nsec int) Time {
+	jt := t.jt
+	return t.date(
+		jt.year+year, jt.month+Month(month), jt.day+day,
+		jt.hour+hour, jt.min+min, jt.sec+sec,
+		jt.nsec+nsec, jt.loc,
+	)
+}
+
+// Truncate returns the result of rounding t down to a multiple of d like time.Time.Truncate.
+func (t Time) Truncate(d time.Duration) Time {
+	return Time{t.jt.fromTime(t.Time().Truncate(d))}
+}
+
+// Round returns the result of rounding t to the nearest multiple of d like time.Time.Round.
+func (t Time) Round(d time.Duration) Time {
+	return Time{t.jt.fromTime(t.Time().Round(d))}
+}
+
+// TruncateTo returns the beginning of the calendar unit of t.
+func (t Time) TruncateTo(unit Unit) Time {
+	switch unit {
+	case UnitHour:
+		t.jt.SetTime(-1, 0, 0, 0)
+		return t
+	case UnitDay:
+		return t.BeginningOfDay()
+	case UnitWeek:
+		return t.BeginningOfWeek()
+	case UnitMonth:
+		return t.BeginningOfMonth()
+	case UnitSeason:
+		return t.BeginningOfSeason()
+	case UnitYear:
+		return t.BeginningOfYear()
+	default:
+		return t
+	}
+}
+
+// CeilTo returns the beginning of the next calendar unit of t.
+// If t is at the beginning of unit it returns t.
+func (t Time) CeilTo(unit Unit) Time {
+	res := t.TruncateTo(unit)
+	if res.Equal(t) {
+		return res
+	}
+	return addUnit(res, unit, 1)
+}
+
+// addUnit add n calendar units to t.
+func addUnit(t Time, unit Unit, n int) Time {
+	switch unit {
+	case UnitHour:
+		return t.AddDatetime(0, 0, 0, n, 0, 0, 0)
+	case UnitDay:
+		return t.AddDate(0, 0, n)
+	case UnitWeek:
+		return t.AddDate(0, 0, 7*n)
+	case UnitMonth:
+		return t.AddDate(0, n, 0)
+	case UnitSeason:
+		return t.AddDate(0, 3*n, 0)
+	case UnitYear:
+		return t.AddDate(n, 0, 0)
+	default:
+		return t
+	}
+}
+
+// Yesterday returns a day before t.
+func (t Time) Yesterday() Time {
+	return t.AddDate(0, 0, -1)
+}
+
+// Tomorrow returns a day after t.
+func (t Time) Tomorrow() Time {
+	return t.AddDate(0, 0, 1)
+}
+
+// BeginningOfDay returns the 00:00:00.000000000 time of the day of t.
+func (t Time) BeginningOfDay() Time {
+	t.jt.SetTime(0, 0, 0, 0)
+	return t
+}
+
+// EndOfDay returns the 23:59:59.999999999 time of the day of t.
+func (t Time) EndOfDay() Time {
+	t.jt.SetTime(23, 59, 59, 999999999)
+	return t
+}
+
+// FirstWeekDay returns the first day of the week of t.
+func (t Time) FirstWeekDay() Time {
+	if t.jt.wday == Shanbeh {
+		return t
+	}
+	return t.AddDate(0, 0, int(Shanbeh-t.jt.wday))
+}
+
+// LastWeekDay returns the last day of the week of t.
+func (t Time) LastWeekDay() Time {
+	if t.jt.wday == Jomeh {
+		return t
+	}
+	return t.AddDate(0, 0, int(Jomeh-t.jt.wday))
+}
+
+// BeginningOfWeek returns the first day of the week of t
+// with time set to 00:00:00.000000000.
+func (t Time) BeginningOfWeek() Time {
+	return t.FirstWeekDay().BeginningOfDay()
+}
+
+// EndOfWeek returns the last day of the week of t
+// with time set to 23:59:59.999999999.
+func (t Time) EndOfWeek() Time {
+	return t.LastWeekDay().EndOfDay()
+}
+
+// FirstMonthDay returns the first day of the month of t.
+func (t Time) FirstMonthDay() Time {
+	jt := t.jt
+	if jt.day == 1 {
+		return t
+	}
+	return t.date(
+		jt.year, jt.month, 1,
+		jt.hour, jt.min, jt.sec,
+		jt.nsec, jt.loc,
+	)
+}
+
+// LastMonthDay returns the last day of the month of t.
+func (t Time) LastMonthDay() Time {
+	jt := t.jt
+	lastDay := jt.monthDays(jt.year, jt.month)
+	if lastDay == jt.day {
+		return t
+	}
+	return t.date(
+		jt.year, jt.month, lastDay,
+		jt.hour, jt.min, jt.sec,
+		jt.nsec, jt.loc,
+	)
+}
+
+// BeginningOfMonth returns the first day of the month of t
+// with time set to 00:00:00.000000000.
+func (t Time) BeginningOfMonth() Time {
+	return t.FirstMonthDay().BeginningOfDay()
+}
+
+// EndOfMonth returns the last day of the month of t
+// with time set to 23:59:59.999999999.
+func (t Time) EndOfMonth() Time {
+	return t.LastMonthDay().EndOfDay()
+}
+
+// BeginningOfSeason returns the first day of the season of t
+// with time set to 00:00:00.000000000.
+func (t Time) BeginningOfSeason() Time {
+	return t.date(
+		t.jt.year, t.jt.Season().FirstMonth(), 1,
+		0, 0, 0, 0, t.jt.loc,
+	)
+}
+
+// EndOfSeason returns the last day of the season of t
+// with time set to 23:59:59.999999999.
+func (t Time) EndOfSeason() Time {
+	return t.BeginningOfSeason().AddDate(0, 2, 0).LastMonthDay().EndOfDay()
+}
+
+// FirstYearDay returns the first day of the year of t.
+func (t Time) FirstYearDay() Time {
+	jt := t.jt
+	if jt.month == Farvardin && jt.day == 1 {
+		return t
+	}
+	return t.date(
+		jt.year, Farvardin, 1,
+		jt.hour, jt.min, jt.sec,
+		jt.nsec, jt.loc,
+	)
+}
+
+// LastYearDay returns the last day of the year of t.
+func (t Time) LastYearDay() Time {
+	jt := t.jt
+	lastDay := jt.monthDays(jt.year, Esfand)
+	if jt.month == Esfand && jt.day == lastDay {
+		return t
+	}
+	return t.date(
+		jt.year, Esfand, lastDay,
+		jt.hour, jt.min, jt.sec,
+		jt.nsec, jt.loc,
+	)
+}
+
+// BeginningOfYear returns the first day of the year of t
+// with time set to 00:00:00.000000000.
+func (t Time) BeginningOfYear() Time {
+	return t.FirstYearDay().BeginningOfDay()
+}
+
+// EndOfYear returns the last day of the year of t
+// with time set to 23:59:59.999999999.
+func (t Time) EndOfYear() Time {
+	return t.LastYearDay().EndOfDay()
+}
+
+// SetYear sets the year of t.
+func (t *Time) SetYear(year int) {
+	t.jt.SetYear(year)
+}
+
+// SetMonth sets the month of t.
+func (t *Time) SetMonth(month Month) {
+	t.jt.SetMonth(month)
+}
+
+// SetDay sets the day of t.
+func (t *Time) SetDay(day int) {
+	t.jt.SetDay(day)
+}
+
+// SetHour sets the hour of t.
+func (t *Time) SetHour(hour int) {
+	t.jt.SetHour(hour)
+}
+
+// SetMinute sets the minute of t.
+func (t *Time) SetMinute(min int) {
+	t.jt.SetMinute(min)
+}
+
+// SetSecond sets the second of t.
+func (t *Time) SetSecond(sec int) {
+	t.jt.SetSecond(sec)
+}
+
+// SetNanosecond sets the nanosecond of t.
+func (t *Time) SetNanosecond(nsec int) {
+	t.jt.SetNanosecond(nsec)
+}
+
+// SetTime sets the hour, minute, second and nanosecond of t.
+// Pass -1 to ignore parameter.
+func (t *Time) SetTime(hour, min, sec, nsec int) {
+	t.jt.SetTime(hour, min, sec, nsec)
+}
+
+// SetDate sets the year, month and day of t.
+// Pass -1 to ignore parameter.
+func (t *Time) SetDate(year, month, day int) {
+	t.jt.SetDate(year, month, day)
+}
+
+// SetDateTime sets the year, month, day, hour, minute, second
+// and nanosecond of t. Pass -1 to ignore parameter.
+func (t *Time) SetDateTime(year, month, day, hour, min, sec, nsec int) {
+	t.jt.SetDateTime(year, month, day, hour, min, sec, nsec)
+}
+
+// WithYear returns t with the year set like SetYear.
+func (t Time) WithYear(year int) Time {
+	t.jt.SetYear(year)
+	return t
+}
+
+// WithMonth returns t with the month set like SetMonth.
+func (t Time) WithMonth(month Month) Time {
+	t.jt.SetMonth(month)
+	return t
+}
+
+// WithDay returns t with the day set like SetDay.
+func (t Time) WithDay(day int) Time {
+	t.jt.SetDay(day)
+	return t
+}
+
+// WithTime returns t with the clock set like SetTime.
+func (t Time) WithTime(hour, min, sec, nsec int) Time {
+	t.jt.SetTime(hour, min, sec, nsec)
+	return t
+}
+
+// WithDate returns t with the date set like Jalaali.WithDate.
+func (t Time) WithDate(year, month, day int) Time {
+	if year > 0 {
+		t.jt.year = year
+	}
+	if month > 0 {
+		t.jt.month = Month(month)
+		t.jt.normalizeMonth()
+	}
+	if day > 0 {
+		t.jt.day = day
+	}
+	t.jt.normalizeDay()
+	t.jt.resetWeekday()
+	return t
+}
+
+// Year returns the year of t.
+func (t Time) Year() int {
+	return t.jt.Year()
+}
+
+// YearDay returns the day of year of t.
+func (t Time) YearDay() int {
+	return t.jt.YearDay()
+}
+
+// YearRemainDays returns the number of remaining days of the year of t.
+func (t Time) YearRemainDays() int {
+	return t.jt.YearRemainDays()
+}
+
+// Month returns the month of t in the range [1, 12].
+func (t Time) Month() Month {
+	return t.jt.Month()
+}
+
+// Season returns the season of t.
+func (t Time) Season() Season {
+	return t.jt.Season()
+}
+
+// Quarter returns the quarter of year of t in the range [1, 4].
+func (t Time) Quarter() int {
+	return t.jt.Quarter()
+}
+
+// SeasonDay returns the day of season of t.
+func (t Time) SeasonDay() int {
+	return t.jt.SeasonDay()
+}
+
+// Hijri returns the hijri qamari date of t using TabularHijri.
+func (t Time) Hijri() Hijri {
+	return t.jt.Hijri()
+}
+
+// Weekday returns the weekday of t.
+func (t Time) Weekday() Weekday {
+	return t.jt.Weekday()
+}
+
+// MonthWeek returns the week of month of t.
+func (t Time) MonthWeek() int {
+	return t.jt.MonthWeek()
+}
+
+// YearWeek returns the week of year of t.
+func (t Time) YearWeek() int {
+	return t.jt.YearWeek()
+}
+
+// YearRemainWeeks returns the number of remaining weeks of the year of t.
+func (t Time) YearRemainWeeks() int {
+	return t.jt.YearRemainWeeks()
+}
+
+// Day returns the day of month of t.
+func (t Time) Day() int {
+	return t.jt.Day()
+}
+
+// MonthRemainDays returns the number of remaining days of the month of t.
+func (t Time) MonthRemainDays() int {
+	return t.jt.MonthRemainDays()
+}
+
+// Hour returns the hour of t in the range [0, 23].
+func (t Time) Hour() int {
+	return t.jt.Hour()
+}
+
+// Hour12 returns the hour of t in the range [0, 11].
+func (t Time) Hour12() int {
+	return t.jt.Hour12()
+}
+
+// Minute returns the minute offset of t in the range [0, 59].
+func (t Time) Minute() int {
+	return t.jt.Minute()
+}
+
+// Second returns the seconds offset of t in the range [0, 59].
+func (t Time) Second() int {
+	return t.jt.Second()
+}
+
+// Nanosecond returns the nanoseconds offset of t in the range [0, 999999999].
+func (t Time) Nanosecond() int {
+	return t.jt.Nanosecond()
+}
+
+// DayTime returns the part of the day of t like Jalaali.DayTime.
+func (t Time) DayTime() DayTime {
+	return t.jt.DayTime()
+}
+
+// Location returns the location of t.
+func (t Time) Location() *time.Location {
+	return t.jt.Location()
+}
+
+// Date returns the year, month and day of t.
+func (t Time) Date() (int, Month, int) {
+	return t.jt.Date()
+}
+
+// Clock returns the hour, minute and second of t.
+func (t Time) Clock() (int, int, int) {
+	return t.jt.Clock()
+}
+
+// Unix returns the number of seconds since January 1, 1970 UTC.
+func (t Time) Unix() int64 {
+	return t.jt.Unix()
+}
+
+// UnixNano returns the number of nanoseconds since January 1, 1970 UTC.
+func (t Time) UnixNano() int64 {
+	return t.jt.UnixNano()
+}
+
+// Time returns t as a Gregorian time.Time.
+func (t Time) Time() time.Time {
+	return t.jt.Time()
+}
+
+// String returns t in RFC3339 format.
+func (t Time) String() string {
+	return t.jt.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface like Jalaali.MarshalJSON.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return t.jt.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface like Jalaali.UnmarshalJSON.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.jt.UnmarshalJSON(data)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t Time) MarshalText() ([]byte, error) {
+	return t.jt.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *Time) UnmarshalText(data []byte) error {
+	return t.jt.UnmarshalText(data)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.jt.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	return t.jt.UnmarshalBinary(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.jt.GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (t *Time) GobDecode(data []byte) error {
+	return t.jt.GobDecode(data)
+}
+
+// Scan implements the sql.Scanner interface like Jalaali.Scan.
+func (t *Time) Scan(value any) error {
+	return t.jt.Scan(value)
+}
+
+// Value implements the driver.Valuer interface like Jalaali.Value.
+func (t Time) Value() (driver.Value, error) {
+	return t.jt.Value()
+}
+
+// Format returns a textual representation of t formatted according to layout.
+func (t Time) Format(layout string) string {
+	return t.jt.Format(layout)
+}
+
+// FormatFa is like Format but writes digits in Persian.
+func (t Time) FormatFa(layout string) string {
+	return t.jt.FormatFa(layout)
+}
+
+// FormatAr is like Format but writes digits in Arabic-Indic.
+func (t Time) FormatAr(layout string) string {
+	return t.jt.FormatAr(layout)
+}
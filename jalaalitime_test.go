@@ -0,0 +1,113 @@
+package gojalaali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mekramy/gojalaali"
+)
+
+func TestTime(t *testing.T) {
+	tz := gojalaali.TehranTz()
+
+	t.Run("Comparable", func(t *testing.T) {
+		a := gojalaali.TimeDate(1403, 1, 1, 10, 0, 0, 0, tz)
+		b := gojalaali.TimeDate(1402, 12, 29, 34, 0, 0, 0, tz)
+		if a != b {
+			t.Errorf("Expect %s == %s", a, b)
+		}
+
+		days := map[gojalaali.Time]string{a: "nowruz"}
+		if days[b] != "nowruz" {
+			t.Error("Expect map lookup by value")
+		}
+
+		// Package time zones are shared instances
+		x := gojalaali.TimeDate(1403, 1, 1, 10, 0, 0, 0, gojalaali.TehranTz())
+		y := gojalaali.TimeDate(1403, 1, 1, 10, 0, 0, 0, gojalaali.TehranTz())
+		if x != y || days[x] != "nowruz" {
+			t.Errorf("Expect %s == %s with separate TehranTz calls", x, y)
+		}
+
+		// Package time zones are kept by binary encoding
+		data, err := x.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var decoded gojalaali.Time
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if decoded != x || days[decoded] != "nowruz" {
+			t.Errorf("Expect decoded %s == %s", decoded, x)
+		}
+
+		// Same instant in other location is Equal but not ==
+		c := gojalaali.NewTime(a.Time().In(time.UTC))
+		if c == a || !c.Equal(a) {
+			t.Errorf("Expect %s equal but not == to %s", c, a)
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		var zero gojalaali.Time
+		if !zero.IsZero() || !zero.Time().IsZero() || zero.String() == "" {
+			t.Error("Expect usable zero value")
+		}
+		if !gojalaali.NewTime(time.Time{}).IsZero() || !gojalaali.TimeOf(nil).IsZero() {
+			t.Error("Expect zero instance")
+		}
+		if !zero.Jalaali().IsZero() {
+			t.Error("Expect zero adapter")
+		}
+	})
+
+	t.Run("Adapter", func(t *testing.T) {
+		j := gojalaali.Date(1403, 6, 31, 14, 30, 0, 0, tz)
+		v := gojalaali.TimeOf(j)
+		tests := []struct {
+			time    gojalaali.Time
+			jalaali gojalaali.Jalaali
+		}{
+			{v.AddDate(0, 1, 0), j.AddDate(0, 1, 0)},
+			{v.AddDateClamped(0, 1, 0), j.AddDateClamped(0, 1, 0)},
+			{v.Add(36 * time.Hour), j.Add(36 * time.Hour)},
+			{v.EndOfWeek(), j.EndOfWeek()},
+			{v.BeginningOfMonth(), j.BeginningOfMonth()},
+			{v.LastMonthDay(), j.LastMonthDay()},
+			{v.EndOfSeason(), j.EndOfSeason()},
+			{v.EndOfYear(), j.EndOfYear()},
+			{v.CeilTo(gojalaali.UnitWeek), j.CeilTo(gojalaali.UnitWeek)},
+			{v.In(time.UTC), j.In(time.UTC)},
+		}
+		for _, test := range tests {
+			if test.time != gojalaali.TimeOf(test.jalaali) {
+				t.Errorf("Expect %s, got %s", test.jalaali, test.time)
+			}
+		}
+
+		if v.Diff(v.AddDate(-1, -2, -3)) != j.Diff(j.AddDate(-1, -2, -3)) {
+			t.Error("Expect same period")
+		}
+
+		var s gojalaali.Time
+		s.SetDateTime(1403, 12, 30, 23, 59, 59, 0)
+		if s.Format("2006-01-02 15:04:05") != "1403-12-30 23:59:59" {
+			t.Errorf("Unexpected set result %s", s)
+		}
+	})
+
+	t.Run("Allocations", func(t *testing.T) {
+		v := gojalaali.TimeDate(1403, 6, 31, 14, 30, 0, 0, tz)
+		allocs := testing.AllocsPerRun(100, func() {
+			res := v.AddDate(0, 1, 3).EndOfMonth().Add(time.Hour).TruncateTo(gojalaali.UnitWeek)
+			_ = res.Before(v) || res.Compare(v) == 0
+			_ = res.Diff(v)
+			_ = res.Hijri()
+			_ = res.MonthWeek()
+		})
+		if allocs != 0 {
+			t.Errorf("Expect no allocation, got %v", allocs)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package gojalaali
+
+import (
+	"strings"
+	"time"
+)
+
+// UnknownZonePolicy specifies how Parser handles
+// unknown zone names of MST layout token.
+type UnknownZonePolicy int
+
+// List of unknown zone policies.
+const (
+	// UnknownZoneError returns ErrUnknownZone.
+	UnknownZoneError UnknownZonePolicy = iota
+	// UnknownZoneIgnore ignores zone name and uses
+	// UTC in Parse and given location in ParseInLocation.
+	UnknownZoneIgnore
+)
+
+// zoneAbbreviations maps built-in time zone abbreviations
+// accepted by MST layout token to their offset in seconds east of UTC.
+var zoneAbbreviations = map[string]int{
+	"UTC":  0,
+	"GMT":  0,
+	"IRST": 12600, // Iran Standard Time
+	"IRDT": 16200, // Iran Daylight Time
+	"AFT":  16200, // Afghanistan Time
+}
+
+// resolveZone resolve zone name of MST layout token.
+// IANA names are loaded from time zone database and package
+// time zones are used as fallback if database is not available.
+// extra abbreviations are checked before built-in abbreviations.
+// It returns nil if zone name is unknown.
+func resolveZone(name string, extra map[string]int) *time.Location {
+	if name == "UTC" {
+		return time.UTC
+	}
+
+	// IANA time zone database
+	if strings.Contains(name, "/") {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+
+	// Package time zones
+	switch name {
+	case TehranTz().String():
+		return TehranTz()
+	case KabulTz().String():
+		return KabulTz()
+	}
+
+	// Abbreviations
+	abbr := strings.ToUpper(name)
+	for key, offset := range extra {
+		if strings.ToUpper(key) == abbr {
+			return time.FixedZone(abbr, offset)
+		}
+	}
+	if offset, ok := zoneAbbreviations[abbr]; ok {
+		return time.FixedZone(abbr, offset)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package gojalaali
+
+import "math"
+
+// unixEpochJulianDay is the julian day of 1970-01-01T00:00:00Z.
+const unixEpochJulianDay = 2440587.5
+
+// equinoxTerms is the periodic terms of march equinox (Meeus, Astronomical Algorithms, table 27.C).
+var equinoxTerms = [][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186},
+	{182, 27.85, 445267.112}, {156, 73.14, 45036.886}, {136, 171.52, 22518.443},
+	{77, 222.54, 65928.934}, {74, 296.72, 3034.906}, {70, 243.58, 9037.513},
+	{58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417},
+	{18, 155.12, 67555.328}, {17, 288.79, 4562.452}, {16, 198.04, 62894.029},
+	{14, 199.76, 31436.921}, {12, 95.39, 14577.848}, {12, 287.11, 31931.756},
+	{12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// earthL is the periodic terms of earth heliocentric longitude (Meeus, Astronomical Algorithms, appendix III).
+var earthL = [][][3]float64{
+	{
+		{175347046, 0, 0}, {3341656, 4.6692568, 6283.0758500}, {34894, 4.62610, 12566.15170},
+		{3497, 2.7441, 5753.3849}, {3418, 2.8289, 3.5231}, {3136, 3.6277, 77713.7715},
+		{2676, 4.4181, 7860.4194}, {2343, 6.1352, 3930.2097}, {1324, 0.7425, 11506.7698},
+		{1273, 2.0371, 529.6910}, {1199, 1.1096, 1577.3435}, {990, 5.233, 5884.927},
+		{902, 2.045, 26.298}, {857, 3.508, 398.149}, {780, 1.179, 5223.694},
+		{753, 2.533, 5507.553}, {505, 4.583, 18849.228}, {492, 4.205, 775.523},
+		{357, 2.920, 0.067}, {317, 5.849, 11790.629}, {284, 1.899, 796.298},
+		{271, 0.315, 10977.079}, {243, 0.345, 5486.778}, {206, 4.806, 2544.314},
+		{205, 1.869, 5573.143}, {202, 2.458, 6069.777}, {156, 0.833, 213.299},
+		{132, 3.411, 2942.463}, {126, 1.083, 20.775}, {115, 0.645, 0.980},
+		{103, 0.636, 4694.003}, {102, 0.976, 15720.839}, {102, 4.267, 7.114},
+		{99, 6.21, 2146.17}, {98, 0.68, 155.42}, {86, 5.98, 161000.69},
+		{85, 1.30, 6275.96}, {85, 3.67, 71430.70}, {80, 1.81, 17260.15},
+		{79, 3.04, 12036.46}, {75, 1.76, 5088.63}, {74, 3.50, 3154.69},
+		{74, 4.68, 801.82}, {70, 0.83, 9437.76}, {62, 3.98, 8827.39},
+		{61, 1.82, 7084.90}, {57, 2.78, 6286.60}, {56, 4.39, 14143.50},
+		{56, 3.47, 6279.55}, {52, 0.19, 12139.55}, {52, 1.33, 1748.02},
+		{51, 0.28, 5856.48}, {49, 0.49, 1194.45}, {41, 5.37, 8429.24},
+		{41, 2.40, 19651.05}, {39, 6.17, 10447.39}, {37, 6.04, 10213.29},
+		{37, 2.57, 1059.38}, {36, 1.71, 2352.87}, {36, 1.78, 6812.77},
+		{33, 0.59, 17789.85}, {30, 0.44, 83996.85}, {30, 2.74, 1349.87},
+		{25, 3.16, 4690.48},
+	},
+	{
+		{628331966747, 0, 0}, {206059, 2.678235, 6283.075850}, {4303, 2.6351, 12566.1517},
+		{425, 1.590, 3.523}, {119, 5.796, 26.298}, {109, 2.966, 1577.344},
+		{93, 2.59, 18849.23}, {72, 1.14, 529.69}, {68, 1.87, 398.15},
+		{67, 4.41, 5507.55}, {59, 2.89, 5223.69}, {56, 2.17, 155.42},
+		{45, 0.40, 796.30}, {36, 0.47, 775.52}, {29, 2.65, 7.11},
+		{21, 5.34, 0.98}, {19, 1.85, 5486.78}, {19, 4.97, 213.30},
+		{17, 2.99, 6275.96}, {16, 0.03, 2544.31}, {16, 1.43, 2146.17},
+		{15, 1.21, 10977.08}, {12, 2.83, 1748.02}, {12, 3.26, 5088.63},
+		{12, 5.27, 1194.45}, {12, 2.08, 4694.00}, {11, 0.77, 553.57},
+		{10, 1.30, 6286.60}, {10, 4.24, 1349.87}, {9, 2.70, 242.73},
+		{9, 5.64, 951.72}, {8, 5.30, 2352.87}, {6, 2.65, 9437.76},
+		{6, 4.67, 4690.48},
+	},
+	{
+		{52919, 0, 0}, {8720, 1.0721, 6283.0758}, {309, 0.867, 12566.152},
+		{27, 0.05, 3.52}, {16, 5.19, 26.30}, {16, 3.68, 155.42},
+		{10, 0.76, 18849.23}, {9, 2.06, 77713.77}, {7, 0.83, 775.52},
+		{5, 4.66, 1577.34}, {4, 1.03, 7.11}, {4, 3.44, 5573.14},
+		{3, 5.14, 796.30}, {3, 6.05, 5507.55}, {3, 1.19, 242.73},
+		{3, 6.12, 529.69}, {3, 0.31, 398.15}, {3, 2.28, 553.57},
+		{2, 4.38, 5223.69}, {2, 3.75, 0.98},
+	},
+	{
+		{289, 5.844, 6283.076}, {35, 0, 0}, {17, 5.49, 12566.15},
+		{3, 5.20, 155.42}, {1, 4.72, 3.52}, {1, 5.30, 18849.23},
+		{1, 5.97, 242.73},
+	},
+	{
+		{114, 3.142, 0}, {8, 4.13, 6283.08}, {1, 3.84, 12566.15},
+	},
+	{
+		{1, 3.14, 0},
+	},
+}
+
+// earthR is the main periodic terms of earth radius vector (Meeus, Astronomical Algorithms, appendix III).
+var earthR = [][][3]float64{
+	{
+		{100013989, 0, 0}, {1670700, 3.0984635, 6283.0758500}, {13956, 3.05525, 12566.15170},
+		{3084, 5.1985, 77713.7715}, {1628, 1.1739, 5753.3849}, {1576, 2.8469, 7860.4194},
+	},
+	{
+		{103019, 1.107490, 6283.075850}, {1721, 1.0644, 12566.1517}, {702, 3.142, 0},
+	},
+	{
+		{4359, 5.7846, 6283.0758}, {124, 5.579, 12566.152},
+	},
+	{
+		{145, 4.273, 6283.076},
+	},
+}
+
+// marchEquinoxJDE returns the julian ephemeris day (dynamical time)
+// of march equinox in gregorian year using Meeus algorithm (chapter 27)
+// as first guess, refined by solving apparent solar longitude for 0°.
+func marchEquinoxJDE(year int) float64 {
+	// Mean equinox
+	var jde0 float64
+	if year < 1000 {
+		y := float64(year) / 1000
+		jde0 = 1721139.29189 + 365242.13740*y + 0.06134*y*y +
+			0.00111*y*y*y - 0.00071*y*y*y*y
+	} else {
+		y := float64(year-2000) / 1000
+		jde0 = 2451623.80984 + 365242.37404*y + 0.05169*y*y -
+			0.00411*y*y*y - 0.00057*y*y*y*y
+	}
+
+	// Periodic terms correction
+	t := (jde0 - 2451545.0) / 36525
+	w := degToRad(35999.373*t - 2.47)
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+
+	s := 0.0
+	for _, term := range equinoxTerms {
+		s += term[0] * math.Cos(degToRad(term[1]+term[2]*t))
+	}
+	jde := jde0 + 0.00001*s/dl
+
+	// Refine until apparent solar longitude is 0° (Meeus, chapter 27, 25)
+	for range 10 {
+		correction := 58 * math.Sin(-degToRad(apparentSolarLongitude(jde)))
+		jde += correction
+		if math.Abs(correction) < 1e-6 {
+			break
+		}
+	}
+	return jde
+}
+
+// apparentSolarLongitude returns the apparent geocentric longitude of sun in degree
+// at julian ephemeris day using VSOP87 terms (Meeus, Astronomical Algorithms, chapter 25).
+func apparentSolarLongitude(jde float64) float64 {
+	tau := (jde - 2451545.0) / 365250
+	l := vsopSeries(earthL, tau)
+	r := vsopSeries(earthR, tau)
+
+	// Geocentric longitude, FK5 correction, nutation and aberration in arcsec
+	lambda := radToDeg(l) + 180
+	lambda += (-0.09033 + nutationInLongitude(jde) - 20.4898/r) / 3600
+	return math.Mod(math.Mod(lambda, 360)+360, 360)
+}
+
+// nutationInLongitude returns the nutation in longitude in arcsec
+// at julian ephemeris day (Meeus, Astronomical Algorithms, chapter 22).
+func nutationInLongitude(jde float64) float64 {
+	t := (jde - 2451545.0) / 36525
+	omega := degToRad(125.04452 - 1934.136261*t)
+	sun := degToRad(280.4665 + 36000.7698*t)
+	moon := degToRad(218.3165 + 481267.8813*t)
+	return -17.20*math.Sin(omega) - 1.32*math.Sin(2*sun) -
+		0.23*math.Sin(2*moon) + 0.21*math.Sin(2*omega)
+}
+
+// vsopSeries evaluates VSOP87 series at tau (julian millennia from J2000).
+func vsopSeries(series [][][3]float64, tau float64) float64 {
+	res := 0.0
+	for i := len(series) - 1; i >= 0; i-- {
+		s := 0.0
+		for _, term := range series[i] {
+			s += term[0] * math.Cos(term[1]+term[2]*tau)
+		}
+		res = res*tau + s
+	}
+	return res / 1e8
+}
+
+// deltaT returns the difference between dynamical time and universal time
+// in seconds for decimal year using Espenak and Meeus polynomial expressions.
+func deltaT(y float64) float64 {
+	poly := func(t float64, c ...float64) float64 {
+		res := 0.0
+		for i := len(c) - 1; i >= 0; i-- {
+			res = res*t + c[i]
+		}
+		return res
+	}
+	longTerm := func(y float64) float64 {
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+
+	switch {
+	case y < -500:
+		return longTerm(y)
+	case y < 500:
+		return poly(y/100, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case y < 1600:
+		return poly((y-1000)/100, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case y < 1700:
+		return poly(y-1600, 120, -0.9808, -0.01532, 1.0/7129)
+	case y < 1800:
+		return poly(y-1700, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case y < 1860:
+		return poly(y-1800, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436, 0.0000121272, -0.0000001699, 0.000000000875)
+	case y < 1900:
+		return poly(y-1860, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case y < 1920:
+		return poly(y-1900, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case y < 1941:
+		return poly(y-1920, 21.20, 0.84493, -0.076100, 0.0020936)
+	case y < 1961:
+		return poly(y-1950, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case y < 1986:
+		return poly(y-1975, 45.45, 1.067, -1.0/260, -1.0/718)
+	case y < 2005:
+		return poly(y-2000, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case y < 2050:
+		return poly(y-2000, 62.92, 0.32217, 0.005589)
+	case y < 2150:
+		return longTerm(y) - 0.5628*(2150-y)
+	default:
+		return longTerm(y)
+	}
+}
+
+func degToRad(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func radToDeg(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -142,7 +142,7 @@ func convertJDNToGregorianPreReform(jdn int) (year, month, day int) {
 	return year, month, day
 }
 
-// convertJDNToShamsi converts a Julian Day Number (JDN) to the Shamsi (Solar Hijri) calendar testDate.
+// arithmeticJDNToShamsi converts a Julian Day Number (JDN) to the Shamsi (Solar Hijri) calendar testDate.
 // The conversion is based on the offset between the Julian calendar and the Shamsi calendar.
 // The calculation is performed as follows:
 // - The JDN is adjusted by subtracting a constant offset to align it with the Shamsi calendar.
@@ -156,7 +156,7 @@ func convertJDNToGregorianPreReform(jdn int) (year, month, day int) {
 // - year: The calculated year in the Shamsi calendar.
 // - month: The calculated month in the Shamsi calendar.
 // - day: The calculated day in the Shamsi calendar.
-func convertJDNToShamsi(jdn int) (year, month, day int) {
+func arithmeticJDNToShamsi(jdn int) (year, month, day int) {
 	const (
 		julianDayToShamsiOffset = 1365393
 		cyclesOf33YearsCount    = 12053 // 33 * 364.24
@@ -194,9 +194,9 @@ func convertJDNToShamsi(jdn int) (year, month, day int) {
 	return year, month, day
 }
 
-// convertShamsiToJDN converts a Shamsi (Solar Hijri) calendar testDate to the corresponding Julian Day Number (JDN).
+// arithmeticShamsiToJDN converts a Shamsi (Solar Hijri) calendar testDate to the corresponding Julian Day Number (JDN).
 // The calculation takes into account the specific offset and adjustments needed for leap years in the Shamsi calendar.
-func convertShamsiToJDN(year, month, day int) int {
+func arithmeticShamsiToJDN(year, month, day int) int {
 	const (
 		shamsiToJulianOffset = 1365392
 		leapYearCycle        = 33
@@ -228,8 +228,8 @@ func convertShamsiToJDN(year, month, day int) int {
 	return jdn
 }
 
-// isLeap check if passed year is a leap year.
-func isLeap(year int) bool {
+// arithmeticIsLeap check if passed year is a leap year using 33-year arithmetic rule.
+func arithmeticIsLeap(year int) bool {
 	var base, result int
 	base = 25*year + 11
 
@@ -241,3 +241,36 @@ func isLeap(year int) bool {
 
 	return result < 8
 }
+
+// convertJDNToShamsi converts a Julian Day Number (JDN) to the Shamsi calendar using ArithmeticRule.
+func convertJDNToShamsi(jdn int) (year, month, day int) {
+	return arithmeticJDNToShamsi(jdn)
+}
+
+// convertShamsiToJDN converts a Shamsi calendar date to the Julian Day Number (JDN) using ArithmeticRule.
+func convertShamsiToJDN(year, month, day int) int {
+	return arithmeticShamsiToJDN(year, month, day)
+}
+
+// isLeap check if passed year is a leap year using ArithmeticRule.
+func isLeap(year int) bool {
+	return arithmeticIsLeap(year)
+}
+
+// convertJDNToGregorian converts a Julian Day Number (JDN) to the Gregorian calendar
+// considering the Gregorian reform.
+func convertJDNToGregorian(jdn int) (year, month, day int) {
+	if jdn > gregorianReformJulianDay {
+		return convertJDNToGregorianPostReform(jdn)
+	}
+	return convertJDNToGregorianPreReform(jdn)
+}
+
+// convertGregorianToJDN converts a Gregorian calendar date to the Julian Day Number (JDN)
+// considering the Gregorian reform.
+func convertGregorianToJDN(year, month, day int) int {
+	if isAfterGregorianReform(year, month, day) {
+		return convertGregorianPostReformToJDN(year, month, day)
+	}
+	return convertGregorianPreReformToJDN(year, month, day)
+}
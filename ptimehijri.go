@@ -0,0 +1,69 @@
+package gojalaali
+
+// A HijriMonth specifies a month of the hijri qamari year starting from Muharram = 1.
+type HijriMonth int
+
+// List of months in hijri qamari calendar.
+const (
+	Muharram HijriMonth = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlUla
+	JumadaAlAkhirah
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+var hijriMonths = []string{
+	"محرم",
+	"صفر",
+	"ربيع الأول",
+	"ربيع الثاني",
+	"جمادى الأولى",
+	"جمادى الآخرة",
+	"رجب",
+	"شعبان",
+	"رمضان",
+	"شوال",
+	"ذو القعدة",
+	"ذو الحجة",
+}
+
+var arabicDays = []string{
+	"السبت",
+	"الأحد",
+	"الاثنين",
+	"الثلاثاء",
+	"الأربعاء",
+	"الخميس",
+	"الجمعة",
+}
+
+// String returns the Arabic name of the month.
+func (m HijriMonth) String() string {
+	switch {
+	case m < 1:
+		return hijriMonths[0]
+	case m > 11:
+		return hijriMonths[11]
+	default:
+		return hijriMonths[m-1]
+	}
+}
+
+// Arabic returns the Arabic name of the day in week.
+func (d Weekday) Arabic() string {
+	switch {
+	case d < 0:
+		return arabicDays[0]
+	case d > 6:
+		return arabicDays[6]
+	default:
+		return arabicDays[d]
+	}
+}
@@ -103,6 +103,38 @@ var shortDariMonths = []string{
 	"حوت",
 }
 
+// Latin transliterations of months, first item is the primary name.
+var latinMonths = [12][]string{
+	{"Farvardin"},
+	{"Ordibehesht"},
+	{"Khordad"},
+	{"Tir"},
+	{"Mordad", "Amordad"},
+	{"Shahrivar"},
+	{"Mehr"},
+	{"Aban"},
+	{"Azar"},
+	{"Dey", "Dei"},
+	{"Bahman"},
+	{"Esfand"},
+}
+
+// Latin transliterations of dari months, first item is the primary name.
+var latinDariMonths = [12][]string{
+	{"Hamal"},
+	{"Sawr", "Sur", "Saur"},
+	{"Jawza", "Jauza", "Jowza"},
+	{"Saratan"},
+	{"Asad"},
+	{"Sonboleh", "Sunbula", "Sonbola"},
+	{"Mizan"},
+	{"Aqrab"},
+	{"Qaws", "Qos", "Qaus"},
+	{"Jadi", "Jady", "Jaddi"},
+	{"Dalw", "Dolv", "Dalv"},
+	{"Hut", "Hoot"},
+}
+
 // {days, leap_days, days_before_start}
 var monthMeta = [12][3]int{
 	{31, 31, 0},   // Farvardin
@@ -167,12 +199,63 @@ func (m Month) DariShort() string {
 	}
 }
 
+// monthDays returns the number of days of month in year.
+func monthDays(year int, month Month) int {
+	return daysOfMonth(month, isLeap(year))
+}
+
+// daysOfMonth returns the number of days of month in leap or common year.
+func daysOfMonth(month Month, leap bool) int {
+	mIndex := month - 1
+	if mIndex < 0 {
+		mIndex = 0
+	} else if mIndex > 11 {
+		mIndex = 11
+	}
+
+	if leap {
+		return monthMeta[mIndex][1]
+	}
+	return monthMeta[mIndex][0]
+}
+
 func monthsStr() string {
-	return strings.Join(slices.Concat(months, dariMonths), "|")
+	return strings.Join(slices.Concat(months, dariMonths), "|") +
+		"|(?i:" + strings.Join(latinMonthsList(false), "|") + ")"
 }
 
 func shortMonthsStr() string {
-	return strings.Join(slices.Concat(shortMonths, shortDariMonths), "|")
+	return strings.Join(slices.Concat(shortMonths, shortDariMonths), "|") +
+		"|(?i:" + strings.Join(latinMonthsList(true), "|") + ")"
+}
+
+// latinMonthsList returns all latin transliterations of months.
+// Short names are the first three letters of primary names.
+func latinMonthsList(isShort bool) []string {
+	var res []string
+	for _, names := range slices.Concat(latinMonths[:], latinDariMonths[:]) {
+		if isShort {
+			res = append(res, names[0][:3])
+		} else {
+			res = append(res, names...)
+		}
+	}
+	return res
+}
+
+// parseLatinMonth parse latin transliteration of month case-insensitively.
+func parseLatinMonth(value string) Month {
+	for i, names := range slices.Concat(latinMonths[:], latinDariMonths[:]) {
+		if strings.EqualFold(value, names[0][:3]) {
+			return Month(i%12 + 1)
+		}
+		for _, name := range names {
+			if strings.EqualFold(value, name) {
+				return Month(i%12 + 1)
+			}
+		}
+	}
+	return 0
 }
 
 func parseMonth(values ...string) Month {
@@ -227,6 +310,10 @@ func parseMonth(values ...string) Month {
 		case "حوت":
 			return Hut
 		}
+
+		if m := parseLatinMonth(month); m > 0 {
+			return m
+		}
 	}
 
 	return 0
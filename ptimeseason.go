@@ -0,0 +1,84 @@
+package gojalaali
+
+import (
+	"slices"
+	"strings"
+)
+
+// A Season specifies a season of the year starting from Bahar = 1.
+// Seasons match the quarters of jalaali year.
+type Season int
+
+// List of seasons in Persian calendar.
+const (
+	Bahar Season = 1 + iota
+	Tabestan
+	Paeez
+	Zemestan
+)
+
+var seasons = []string{
+	"بهار",
+	"تابستان",
+	"پاییز",
+	"زمستان",
+}
+
+var dariSeasons = []string{
+	"بهار",
+	"تابستان",
+	"خزان",
+	"زمستان",
+}
+
+// String returns the Persian name of the season.
+func (s Season) String() string {
+	switch {
+	case s < Bahar:
+		return seasons[0]
+	case s > Zemestan:
+		return seasons[3]
+	default:
+		return seasons[s-1]
+	}
+}
+
+// Dari returns the Dari name of the season.
+func (s Season) Dari() string {
+	switch {
+	case s < Bahar:
+		return dariSeasons[0]
+	case s > Zemestan:
+		return dariSeasons[3]
+	default:
+		return dariSeasons[s-1]
+	}
+}
+
+// FirstMonth returns the first month of the season.
+func (s Season) FirstMonth() Month {
+	switch {
+	case s < Bahar:
+		return Farvardin
+	case s > Zemestan:
+		return Dey
+	default:
+		return Month(s-1)*3 + 1
+	}
+}
+
+func seasonsStr() string {
+	return strings.Join(slices.Concat(seasons, dariSeasons), "|")
+}
+
+func parseSeason(values ...string) Season {
+	for _, value := range values {
+		if i := slices.Index(seasons, value); i >= 0 {
+			return Season(i + 1)
+		}
+		if i := slices.Index(dariSeasons, value); i >= 0 {
+			return Season(i + 1)
+		}
+	}
+	return 0
+}
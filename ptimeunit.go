@@ -0,0 +1,14 @@
+package gojalaali
+
+// A Unit specifies a jalaali calendar unit used for truncating and rounding.
+type Unit int
+
+// List of calendar units.
+const (
+	UnitHour Unit = iota
+	UnitDay
+	UnitWeek // Weeks start on Shanbeh
+	UnitMonth
+	UnitSeason // Seasons start on 1 Farvardin, 1 Tir, 1 Mehr and 1 Dey
+	UnitYear
+)
@@ -4,6 +4,7 @@
 package gojalaali
 
 import (
+	"slices"
 	"strings"
 	"time"
 )
@@ -118,3 +119,18 @@ func daysStr() string {
 func shortDaysStr() string {
 	return strings.Join(shortDays, "|")
 }
+
+func parseWeekday(values ...string) (Weekday, bool) {
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		if i := slices.Index(days, value); i >= 0 {
+			return Weekday(i), true
+		}
+		if i := slices.Index(shortDays, value); i >= 0 {
+			return Weekday(i), true
+		}
+	}
+	return 0, false
+}